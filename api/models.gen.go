@@ -13,30 +13,41 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for PVZCity.
+// Defines values for HealthStatus.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	Ok          HealthStatus = "ok"
+	Unavailable HealthStatus = "unavailable"
 )
 
-// Defines values for ProductType.
+// Defines values for PVZEventType.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	ProductAdded       PVZEventType = "product.added"
+	ProductDeleted     PVZEventType = "product.deleted"
+	ReceptionCancelled PVZEventType = "reception.cancelled"
+	ReceptionClosed    PVZEventType = "reception.closed"
+	ReceptionOpened    PVZEventType = "reception.opened"
+	ReceptionVerified  PVZEventType = "reception.verified"
+)
+
+// Defines values for ProductBatchResultStatus.
+const (
+	Created  ProductBatchResultStatus = "created"
+	Rejected ProductBatchResultStatus = "rejected"
 )
 
 // Defines values for ReceptionStatus.
 const (
-	Close      ReceptionStatus = "close"
-	InProgress ReceptionStatus = "in_progress"
+	ReceptionStatusCancelled  ReceptionStatus = "cancelled"
+	ReceptionStatusClosed     ReceptionStatus = "closed"
+	ReceptionStatusInProgress ReceptionStatus = "in_progress"
+	ReceptionStatusVerified   ReceptionStatus = "verified"
 )
 
-// Defines values for UserRole.
+// Defines values for RolePermissionScope.
 const (
-	UserRoleEmployee  UserRole = "employee"
-	UserRoleModerator UserRole = "moderator"
+	All      RolePermissionScope = "all"
+	Assigned RolePermissionScope = "assigned"
+	City     RolePermissionScope = "city"
 )
 
 // Defines values for PostDummyLoginJSONBodyRole.
@@ -45,68 +56,283 @@ const (
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for GetPvzPvzIdReceptionsParamsStatus.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	GetPvzPvzIdReceptionsParamsStatusCancelled  GetPvzPvzIdReceptionsParamsStatus = "cancelled"
+	GetPvzPvzIdReceptionsParamsStatusClosed     GetPvzPvzIdReceptionsParamsStatus = "closed"
+	GetPvzPvzIdReceptionsParamsStatusInProgress GetPvzPvzIdReceptionsParamsStatus = "in_progress"
+	GetPvzPvzIdReceptionsParamsStatusVerified   GetPvzPvzIdReceptionsParamsStatus = "verified"
 )
 
 // Defines values for PostRegisterJSONBodyRole.
 const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
+	PostRegisterJSONBodyRoleEmployee  PostRegisterJSONBodyRole = "employee"
+	PostRegisterJSONBodyRoleModerator PostRegisterJSONBodyRole = "moderator"
 )
 
+// CatalogEntry defines model for CatalogEntry.
+type CatalogEntry struct {
+	// Active Неактивное значение нельзя использовать для новых записей
+	Active bool   `json:"active"`
+	Name   string `json:"name"`
+}
+
+// Dimensions Габариты товара в миллиметрах
+type Dimensions struct {
+	HeightMm int `json:"heightMm"`
+	LengthMm int `json:"lengthMm"`
+	WidthMm  int `json:"widthMm"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// Health defines model for Health.
+type Health struct {
+	// Checks Результат каждой проверки, ok или текст ошибки
+	Checks *map[string]string `json:"checks,omitempty"`
+	Status HealthStatus       `json:"status"`
+}
+
+// HealthStatus defines model for Health.Status.
+type HealthStatus string
+
+// JWK defines model for JWK.
+type JWK struct {
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+	X   *string `json:"x,omitempty"`
+}
+
+// JWKS defines model for JWKS.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
-	City             PVZCity             `json:"city"`
+	// City Город из справочника городов
+	City string `json:"city"`
+
+	// DeactivatedAt Время деактивации ПВЗ
+	DeactivatedAt    *time.Time          `json:"deactivatedAt,omitempty"`
 	Id               *openapi_types.UUID `json:"id,omitempty"`
 	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
 }
 
-// PVZCity defines model for PVZ.City.
-type PVZCity string
+// PVZEvent Событие ПВЗ, передается в поле data потока text/event-stream. Поле id
+// потока совпадает с id события, поле event - с type.
+type PVZEvent struct {
+	// ActorId Пользователь, совершивший действие
+	ActorId   *openapi_types.UUID `json:"actorId,omitempty"`
+	City      string              `json:"city"`
+	CreatedAt time.Time           `json:"createdAt"`
+
+	// Id Возрастающий номер события, используется в Last-Event-ID
+	Id int64 `json:"id"`
+
+	// ProductId Только для событий product.*
+	ProductId *openapi_types.UUID `json:"productId,omitempty"`
+
+	// ProductType Только для событий product.*
+	ProductType *string            `json:"productType,omitempty"`
+	PvzId       openapi_types.UUID `json:"pvzId"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Type        PVZEventType       `json:"type"`
+}
+
+// PVZEventType defines model for PVZEvent.Type.
+type PVZEventType string
+
+// PVZWithReceptions defines model for PVZWithReceptions.
+type PVZWithReceptions struct {
+	Pvz        PVZ `json:"pvz"`
+	Receptions []struct {
+		Products  *[]Product `json:"products,omitempty"`
+		Reception *Reception `json:"reception,omitempty"`
+	} `json:"receptions"`
+}
 
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty"`
+	// Barcode Штрихкод, уникален в пределах приемки
+	Barcode *string `json:"barcode,omitempty"`
+
+	// CreatedBy Сотрудник, принявший товар
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  *time.Time          `json:"dateTime,omitempty"`
+
+	// DeletedAt Время отмены приемки товара, если товар удален
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// DeletedBy Сотрудник, удаливший товар
+	DeletedBy *openapi_types.UUID `json:"deletedBy,omitempty"`
+
+	// Dimensions Габариты товара в миллиметрах
+	Dimensions  *Dimensions         `json:"dimensions,omitempty"`
 	Id          *openapi_types.UUID `json:"id,omitempty"`
 	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+	Sku         *string             `json:"sku,omitempty"`
+
+	// Type Тип товара из справочника типов
+	Type        string `json:"type"`
+	WeightGrams *int   `json:"weightGrams,omitempty"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// ProductBatchItem Товар в пакетном добавлении
+type ProductBatchItem struct {
+	// Barcode Штрихкод, уникален в пределах приемки
+	Barcode *string `json:"barcode,omitempty"`
+
+	// Dimensions Габариты товара в миллиметрах
+	Dimensions *Dimensions `json:"dimensions,omitempty"`
+	Sku        *string     `json:"sku,omitempty"`
+
+	// Type Тип товара из справочника типов
+	Type        string `json:"type"`
+	WeightGrams *int   `json:"weightGrams,omitempty"`
+}
+
+// ProductBatchResponse defines model for ProductBatchResponse.
+type ProductBatchResponse struct {
+	// Results Результаты в порядке товаров в запросе
+	Results []ProductBatchResult `json:"results"`
+}
+
+// ProductBatchResult Результат добавления одного товара из пакета
+type ProductBatchResult struct {
+	// Error Причина отказа для status=rejected
+	Error *string `json:"error,omitempty"`
+
+	// Index Позиция товара в запросе, с нуля
+	Index   int                      `json:"index"`
+	Product *Product                 `json:"product,omitempty"`
+	Status  ProductBatchResultStatus `json:"status"`
+}
+
+// ProductBatchResultStatus defines model for ProductBatchResult.Status.
+type ProductBatchResultStatus string
 
 // Reception defines model for Reception.
 type Reception struct {
-	DateTime time.Time           `json:"dateTime"`
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	PvzId    openapi_types.UUID  `json:"pvzId"`
-	Status   ReceptionStatus     `json:"status"`
+	// ClosedBy Сотрудник, закрывший приемку
+	ClosedBy *openapi_types.UUID `json:"closedBy,omitempty"`
+
+	// CreatedBy Сотрудник, открывший приемку
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  time.Time           `json:"dateTime"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	PvzId     openapi_types.UUID  `json:"pvzId"`
+
+	// Status in_progress -> closed -> verified; приемку в процессе можно
+	// отменить (cancelled), закрытую - переоткрыть (in_progress)
+	Status ReceptionStatus `json:"status"`
 }
 
-// ReceptionStatus defines model for Reception.Status.
+// ReceptionStatus in_progress -> closed -> verified; приемку в процессе можно
+// отменить (cancelled), закрытую - переоткрыть (in_progress)
 type ReceptionStatus string
 
+// ReceptionPage defines model for ReceptionPage.
+type ReceptionPage struct {
+	Items []Reception `json:"items"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// ReceptionTransition Смена статуса приемки
+type ReceptionTransition struct {
+	// ActorId Пользователь, сменивший статус
+	ActorId    *openapi_types.UUID `json:"actorId,omitempty"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	FromStatus string              `json:"fromStatus"`
+	Id         int64               `json:"id"`
+
+	// Reason Причина, обязательна при переоткрытии
+	Reason   *string `json:"reason,omitempty"`
+	ToStatus string  `json:"toStatus"`
+}
+
+// ReceptionWithProducts defines model for ReceptionWithProducts.
+type ReceptionWithProducts struct {
+	Products  []Product `json:"products"`
+	Reception Reception `json:"reception"`
+}
+
+// Role defines model for Role.
+type Role struct {
+	Description *string          `json:"description,omitempty"`
+	Name        string           `json:"name"`
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// RolePermission defines model for RolePermission.
+type RolePermission struct {
+	Permission string              `json:"permission"`
+	Scope      RolePermissionScope `json:"scope"`
+}
+
+// RolePermissionScope defines model for RolePermission.Scope.
+type RolePermissionScope string
+
+// StaffAssignment defines model for StaffAssignment.
+type StaffAssignment struct {
+	AssignedAt *time.Time           `json:"assignedAt,omitempty"`
+	AssignedBy *openapi_types.UUID  `json:"assignedBy,omitempty"`
+	Email      *openapi_types.Email `json:"email,omitempty"`
+	PvzId      openapi_types.UUID   `json:"pvzId"`
+	UserId     openapi_types.UUID   `json:"userId"`
+}
+
 // Token defines model for Token.
 type Token = string
 
+// TokenPair defines model for TokenPair.
+type TokenPair struct {
+	ExpiresAt    time.Time `json:"expiresAt"`
+	RefreshToken string    `json:"refreshToken"`
+	Token        Token     `json:"token"`
+}
+
 // User defines model for User.
 type User struct {
+	// City Город, которым ограничены права роли с областью city
+	City  *string             `json:"city,omitempty"`
 	Email openapi_types.Email `json:"email"`
 	Id    *openapi_types.UUID `json:"id,omitempty"`
-	Role  UserRole            `json:"role"`
+
+	// Role Имя роли из справочника ролей
+	Role string `json:"role"`
+}
+
+// Version defines model for Version.
+type Version struct {
+	BuildTime *string `json:"buildTime,omitempty"`
+
+	// Commit git SHA сборки
+	Commit    string `json:"commit"`
+	GoVersion string `json:"goVersion"`
+	Version   string `json:"version"`
 }
 
-// UserRole defines model for User.Role.
-type UserRole string
+// PutCitiesNameJSONBody defines parameters for PutCitiesName.
+type PutCitiesNameJSONBody struct {
+	Active bool `json:"active"`
+}
+
+// GetCitiesNameEventsParams defines parameters for GetCitiesNameEvents.
+type GetCitiesNameEventsParams struct {
+	// LastEventID Id последнего полученного события; пропущенные после него события будут отправлены первыми
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
 
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
@@ -122,14 +348,30 @@ type PostLoginJSONBody struct {
 	Password string              `json:"password"`
 }
 
+// PostLogoutJSONBody defines parameters for PostLogout.
+type PostLogoutJSONBody struct {
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
+// PutProductTypesNameJSONBody defines parameters for PutProductTypesName.
+type PutProductTypesNameJSONBody struct {
+	Active bool `json:"active"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
-}
+	// Barcode Штрихкод, уникален в пределах приемки
+	Barcode *string `json:"barcode,omitempty"`
+
+	// Dimensions Габариты товара в миллиметрах
+	Dimensions *Dimensions        `json:"dimensions,omitempty"`
+	PvzId      openapi_types.UUID `json:"pvzId"`
+	Sku        *string            `json:"sku,omitempty"`
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+	// Type Тип товара из справочника типов
+	Type        string `json:"type"`
+	WeightGrams *int   `json:"weightGrams,omitempty"`
+}
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
@@ -144,6 +386,53 @@ type GetPvzParams struct {
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IncludeDeleted Показывать удаленные товары
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
+}
+
+// GetPvzPvzIdParams defines parameters for GetPvzPvzId.
+type GetPvzPvzIdParams struct {
+	// IncludeDeleted Показывать удаленные товары
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
+}
+
+// PatchPvzPvzIdJSONBody defines parameters for PatchPvzPvzId.
+type PatchPvzPvzIdJSONBody struct {
+	// City Город из справочника городов
+	City *string `json:"city,omitempty"`
+}
+
+// GetPvzPvzIdEventsParams defines parameters for GetPvzPvzIdEvents.
+type GetPvzPvzIdEventsParams struct {
+	// LastEventID Id последнего полученного события; пропущенные после него события будут отправлены первыми
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// GetPvzPvzIdReceptionsParams defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParams struct {
+	// Status Статус приемки
+	Status *GetPvzPvzIdReceptionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// Cursor Значение nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPvzPvzIdReceptionsParamsStatus defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParamsStatus string
+
+// PostPvzPvzIdStaffJSONBody defines parameters for PostPvzPvzIdStaff.
+type PostPvzPvzIdStaffJSONBody struct {
+	UserId openapi_types.UUID `json:"userId"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
@@ -151,6 +440,22 @@ type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
+// GetReceptionsReceptionIdParams defines parameters for GetReceptionsReceptionId.
+type GetReceptionsReceptionIdParams struct {
+	// IncludeDeleted Показывать удаленные товары
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
+}
+
+// PostReceptionsReceptionIdProductsBatchJSONBody defines parameters for PostReceptionsReceptionIdProductsBatch.
+type PostReceptionsReceptionIdProductsBatchJSONBody struct {
+	Products []ProductBatchItem `json:"products"`
+}
+
+// PostReceptionsReceptionIdReopenJSONBody defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenJSONBody struct {
+	Reason string `json:"reason"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
 	Email    openapi_types.Email      `json:"email"`
@@ -161,20 +466,88 @@ type PostRegisterJSONBody struct {
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
+// PutRolesRoleJSONBody defines parameters for PutRolesRole.
+type PutRolesRoleJSONBody struct {
+	Description *string          `json:"description,omitempty"`
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// PostTokenRefreshJSONBody defines parameters for PostTokenRefresh.
+type PostTokenRefreshJSONBody struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostUsersJSONBody defines parameters for PostUsers.
+type PostUsersJSONBody struct {
+	City     *string             `json:"city,omitempty"`
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+	Role     string              `json:"role"`
+}
+
+// PatchUsersUserIdJSONBody defines parameters for PatchUsersUserId.
+type PatchUsersUserIdJSONBody struct {
+	// City Пустая строка снимает привязку к городу
+	City *string `json:"city,omitempty"`
+	Role *string `json:"role,omitempty"`
+}
+
+// PutCitiesNameJSONRequestBody defines body for PutCitiesName for application/json ContentType.
+type PutCitiesNameJSONRequestBody PutCitiesNameJSONBody
+
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostLogoutJSONRequestBody defines body for PostLogout for application/json ContentType.
+type PostLogoutJSONRequestBody PostLogoutJSONBody
+
+// PutProductTypesNameJSONRequestBody defines body for PutProductTypesName for application/json ContentType.
+type PutProductTypesNameJSONRequestBody PutProductTypesNameJSONBody
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PatchPvzPvzIdJSONRequestBody defines body for PatchPvzPvzId for application/json ContentType.
+type PatchPvzPvzIdJSONRequestBody PatchPvzPvzIdJSONBody
+
+// PostPvzPvzIdStaffJSONRequestBody defines body for PostPvzPvzIdStaff for application/json ContentType.
+type PostPvzPvzIdStaffJSONRequestBody PostPvzPvzIdStaffJSONBody
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PostReceptionsReceptionIdProductsBatchJSONRequestBody defines body for PostReceptionsReceptionIdProductsBatch for application/json ContentType.
+type PostReceptionsReceptionIdProductsBatchJSONRequestBody PostReceptionsReceptionIdProductsBatchJSONBody
+
+// PostReceptionsReceptionIdReopenJSONRequestBody defines body for PostReceptionsReceptionIdReopen for application/json ContentType.
+type PostReceptionsReceptionIdReopenJSONRequestBody PostReceptionsReceptionIdReopenJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
+
+// PutRolesRoleJSONRequestBody defines body for PutRolesRole for application/json ContentType.
+type PutRolesRoleJSONRequestBody PutRolesRoleJSONBody
+
+// PostTokenRefreshJSONRequestBody defines body for PostTokenRefresh for application/json ContentType.
+type PostTokenRefreshJSONRequestBody PostTokenRefreshJSONBody
+
+// PostUsersJSONRequestBody defines body for PostUsers for application/json ContentType.
+type PostUsersJSONRequestBody PostUsersJSONBody
+
+// PatchUsersUserIdJSONRequestBody defines body for PatchUsersUserId for application/json ContentType.
+type PatchUsersUserIdJSONRequestBody PatchUsersUserIdJSONBody
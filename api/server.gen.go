@@ -14,12 +14,39 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Публичные ключи для проверки JWT (JWKS)
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx echo.Context) error
+	// Действующая конфигурация сервера, секреты скрыты (право config:read)
+	// (GET /admin/config)
+	GetAdminConfig(ctx echo.Context) error
+	// Справочник городов
+	// (GET /cities)
+	GetCities(ctx echo.Context) error
+	// Добавление города или изменение активности (право catalog:manage)
+	// (PUT /cities/{name})
+	PutCitiesName(ctx echo.Context, name string) error
+	// Поток событий всех ПВЗ города
+	// (GET /cities/{name}/events)
+	GetCitiesNameEvents(ctx echo.Context, name string, params GetCitiesNameEventsParams) error
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(ctx echo.Context) error
+	// Процесс жив (liveness)
+	// (GET /healthz)
+	GetHealthz(ctx echo.Context) error
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(ctx echo.Context) error
+	// Выход из системы с отзывом токена доступа и refresh-токена
+	// (POST /logout)
+	PostLogout(ctx echo.Context) error
+	// Справочник типов товаров
+	// (GET /product_types)
+	GetProductTypes(ctx echo.Context) error
+	// Добавление типа товара или изменение активности (право catalog:manage)
+	// (PUT /product_types/{name})
+	PutProductTypesName(ctx echo.Context, name string) error
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(ctx echo.Context) error
@@ -29,18 +56,87 @@ type ServerInterface interface {
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(ctx echo.Context) error
+	// Деактивация ПВЗ (право pvz:delete)
+	// (DELETE /pvz/{pvzId})
+	DeletePvzPvzId(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Получение ПВЗ с приемками и товарами
+	// (GET /pvz/{pvzId})
+	GetPvzPvzId(ctx echo.Context, pvzId openapi_types.UUID, params GetPvzPvzIdParams) error
+	// Изменение ПВЗ (право pvz:update)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(ctx echo.Context, pvzId openapi_types.UUID) error
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ
 	// (POST /pvz/{pvzId}/close_last_reception)
 	PostPvzPvzIdCloseLastReception(ctx echo.Context, pvzId openapi_types.UUID) error
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Поток событий ПВЗ (приемки и товары)
+	// (GET /pvz/{pvzId}/events)
+	GetPvzPvzIdEvents(ctx echo.Context, pvzId openapi_types.UUID, params GetPvzPvzIdEventsParams) error
+	// История приемок ПВЗ с фильтрацией и курсорной пагинацией
+	// (GET /pvz/{pvzId}/receptions)
+	GetPvzPvzIdReceptions(ctx echo.Context, pvzId openapi_types.UUID, params GetPvzPvzIdReceptionsParams) error
+	// Текущая открытая приемка ПВЗ с товарами
+	// (GET /pvz/{pvzId}/receptions/current)
+	GetPvzPvzIdReceptionsCurrent(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Список сотрудников, закрепленных за ПВЗ (только для модераторов)
+	// (GET /pvz/{pvzId}/staff)
+	GetPvzPvzIdStaff(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Закрепление сотрудника за ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/staff)
+	PostPvzPvzIdStaff(ctx echo.Context, pvzId openapi_types.UUID) error
+	// Открепление сотрудника от ПВЗ (только для модераторов)
+	// (DELETE /pvz/{pvzId}/staff/{userId})
+	DeletePvzPvzIdStaffUserId(ctx echo.Context, pvzId openapi_types.UUID, userId openapi_types.UUID) error
+	// Готовность принимать трафик (БД, подготовленные запросы, миграции)
+	// (GET /readyz)
+	GetReadyz(ctx echo.Context) error
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(ctx echo.Context) error
+	// Получение приемки с товарами
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(ctx echo.Context, receptionId openapi_types.UUID, params GetReceptionsReceptionIdParams) error
+	// Отмена приемки в процессе (право reception:cancel, у сотрудников ПВЗ)
+	// (POST /receptions/{receptionId}/cancel)
+	PostReceptionsReceptionIdCancel(ctx echo.Context, receptionId openapi_types.UUID) error
+	// Пакетное добавление товаров в приемку в процессе (только для сотрудников ПВЗ)
+	// (POST /receptions/{receptionId}/products:batch)
+	PostReceptionsReceptionIdProductsBatch(ctx echo.Context, receptionId openapi_types.UUID) error
+	// Переоткрытие закрытой приемки с указанием причины (право reception:reopen, у модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(ctx echo.Context, receptionId openapi_types.UUID) error
+	// История смены статусов приемки
+	// (GET /receptions/{receptionId}/transitions)
+	GetReceptionsReceptionIdTransitions(ctx echo.Context, receptionId openapi_types.UUID) error
+	// Подтверждение закрытой приемки (право reception:verify, у модераторов)
+	// (POST /receptions/{receptionId}/verify)
+	PostReceptionsReceptionIdVerify(ctx echo.Context, receptionId openapi_types.UUID) error
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(ctx echo.Context) error
+	// Список ролей и их прав (право role:read)
+	// (GET /roles)
+	GetRoles(ctx echo.Context) error
+	// Создание роли или замена ее прав (право role:manage)
+	// (PUT /roles/{role})
+	PutRolesRole(ctx echo.Context, role string) error
+	// Обновление токена доступа по refresh-токену
+	// (POST /token/refresh)
+	PostTokenRefresh(ctx echo.Context) error
+	// Список пользователей (право user:read)
+	// (GET /users)
+	GetUsers(ctx echo.Context, params GetUsersParams) error
+	// Создание пользователя с любой ролью (право user:manage)
+	// (POST /users)
+	PostUsers(ctx echo.Context) error
+	// Изменение роли и города пользователя (право user:manage)
+	// (PATCH /users/{userId})
+	PatchUsersUserId(ctx echo.Context, userId openapi_types.UUID) error
+	// Версия сборки
+	// (GET /version)
+	GetVersion(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -48,6 +144,93 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetWellKnownJwksJson converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWellKnownJwksJson(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWellKnownJwksJson(ctx)
+	return err
+}
+
+// GetAdminConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminConfig(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminConfig(ctx)
+	return err
+}
+
+// GetCities converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCities(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCities(ctx)
+	return err
+}
+
+// PutCitiesName converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCitiesName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCitiesName(ctx, name)
+	return err
+}
+
+// GetCitiesNameEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCitiesNameEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCitiesNameEventsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCitiesNameEvents(ctx, name, params)
+	return err
+}
+
 // PostDummyLogin converts echo context to params.
 func (w *ServerInterfaceWrapper) PostDummyLogin(ctx echo.Context) error {
 	var err error
@@ -57,6 +240,15 @@ func (w *ServerInterfaceWrapper) PostDummyLogin(ctx echo.Context) error {
 	return err
 }
 
+// GetHealthz converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealthz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealthz(ctx)
+	return err
+}
+
 // PostLogin converts echo context to params.
 func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
 	var err error
@@ -66,6 +258,46 @@ func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
 	return err
 }
 
+// PostLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLogout(ctx)
+	return err
+}
+
+// GetProductTypes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProductTypes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProductTypes(ctx)
+	return err
+}
+
+// PutProductTypesName converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProductTypesName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutProductTypesName(ctx, name)
+	return err
+}
+
 // PostProducts converts echo context to params.
 func (w *ServerInterfaceWrapper) PostProducts(ctx echo.Context) error {
 	var err error
@@ -113,6 +345,13 @@ func (w *ServerInterfaceWrapper) GetPvz(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
+	// ------------- Optional query parameter "includeDeleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDeleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeDeleted: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetPvz(ctx, params)
 	return err
@@ -129,6 +368,69 @@ func (w *ServerInterfaceWrapper) PostPvz(ctx echo.Context) error {
 	return err
 }
 
+// DeletePvzPvzId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePvzPvzId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePvzPvzId(ctx, pvzId)
+	return err
+}
+
+// GetPvzPvzId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPvzPvzId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdParams
+	// ------------- Optional query parameter "includeDeleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDeleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeDeleted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPvzPvzId(ctx, pvzId, params)
+	return err
+}
+
+// PatchPvzPvzId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchPvzPvzId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchPvzPvzId(ctx, pvzId)
+	return err
+}
+
 // PostPvzPvzIdCloseLastReception converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(ctx echo.Context) error {
 	var err error
@@ -165,6 +467,188 @@ func (w *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(ctx echo.Context)
 	return err
 }
 
+// GetPvzPvzIdEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPvzPvzIdEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdEventsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPvzPvzIdEvents(ctx, pvzId, params)
+	return err
+}
+
+// GetPvzPvzIdReceptions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPvzPvzIdReceptions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdReceptionsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter startDate: %s", err))
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter endDate: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPvzPvzIdReceptions(ctx, pvzId, params)
+	return err
+}
+
+// GetPvzPvzIdReceptionsCurrent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPvzPvzIdReceptionsCurrent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPvzPvzIdReceptionsCurrent(ctx, pvzId)
+	return err
+}
+
+// GetPvzPvzIdStaff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPvzPvzIdStaff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPvzPvzIdStaff(ctx, pvzId)
+	return err
+}
+
+// PostPvzPvzIdStaff converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPvzPvzIdStaff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPvzPvzIdStaff(ctx, pvzId)
+	return err
+}
+
+// DeletePvzPvzIdStaffUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePvzPvzIdStaffUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, ctx.Param("pvzId"), &pvzId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pvzId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePvzPvzIdStaffUserId(ctx, pvzId, userId)
+	return err
+}
+
+// GetReadyz converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReadyz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReadyz(ctx)
+	return err
+}
+
 // PostReceptions converts echo context to params.
 func (w *ServerInterfaceWrapper) PostReceptions(ctx echo.Context) error {
 	var err error
@@ -176,6 +660,123 @@ func (w *ServerInterfaceWrapper) PostReceptions(ctx echo.Context) error {
 	return err
 }
 
+// GetReceptionsReceptionId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReceptionsReceptionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "receptionId", runtime.ParamLocationPath, ctx.Param("receptionId"), &receptionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReceptionsReceptionIdParams
+	// ------------- Optional query parameter "includeDeleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDeleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeDeleted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReceptionsReceptionId(ctx, receptionId, params)
+	return err
+}
+
+// PostReceptionsReceptionIdCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReceptionsReceptionIdCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "receptionId", runtime.ParamLocationPath, ctx.Param("receptionId"), &receptionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReceptionsReceptionIdCancel(ctx, receptionId)
+	return err
+}
+
+// PostReceptionsReceptionIdProductsBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReceptionsReceptionIdProductsBatch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "receptionId", runtime.ParamLocationPath, ctx.Param("receptionId"), &receptionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReceptionsReceptionIdProductsBatch(ctx, receptionId)
+	return err
+}
+
+// PostReceptionsReceptionIdReopen converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "receptionId", runtime.ParamLocationPath, ctx.Param("receptionId"), &receptionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReceptionsReceptionIdReopen(ctx, receptionId)
+	return err
+}
+
+// GetReceptionsReceptionIdTransitions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReceptionsReceptionIdTransitions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "receptionId", runtime.ParamLocationPath, ctx.Param("receptionId"), &receptionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReceptionsReceptionIdTransitions(ctx, receptionId)
+	return err
+}
+
+// PostReceptionsReceptionIdVerify converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReceptionsReceptionIdVerify(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "receptionId", runtime.ParamLocationPath, ctx.Param("receptionId"), &receptionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter receptionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReceptionsReceptionIdVerify(ctx, receptionId)
+	return err
+}
+
 // PostRegister converts echo context to params.
 func (w *ServerInterfaceWrapper) PostRegister(ctx echo.Context) error {
 	var err error
@@ -185,6 +786,109 @@ func (w *ServerInterfaceWrapper) PostRegister(ctx echo.Context) error {
 	return err
 }
 
+// GetRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoles(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoles(ctx)
+	return err
+}
+
+// PutRolesRole converts echo context to params.
+func (w *ServerInterfaceWrapper) PutRolesRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "role" -------------
+	var role string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "role", runtime.ParamLocationPath, ctx.Param("role"), &role)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutRolesRole(ctx, role)
+	return err
+}
+
+// PostTokenRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTokenRefresh(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTokenRefresh(ctx)
+	return err
+}
+
+// GetUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsers(ctx, params)
+	return err
+}
+
+// PostUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsers(ctx)
+	return err
+}
+
+// PatchUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchUsersUserId(ctx, userId)
+	return err
+}
+
+// GetVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) GetVersion(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetVersion(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -213,14 +917,46 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	router.GET(baseURL+"/admin/config", wrapper.GetAdminConfig)
+	router.GET(baseURL+"/cities", wrapper.GetCities)
+	router.PUT(baseURL+"/cities/:name", wrapper.PutCitiesName)
+	router.GET(baseURL+"/cities/:name/events", wrapper.GetCitiesNameEvents)
 	router.POST(baseURL+"/dummyLogin", wrapper.PostDummyLogin)
+	router.GET(baseURL+"/healthz", wrapper.GetHealthz)
 	router.POST(baseURL+"/login", wrapper.PostLogin)
+	router.POST(baseURL+"/logout", wrapper.PostLogout)
+	router.GET(baseURL+"/product_types", wrapper.GetProductTypes)
+	router.PUT(baseURL+"/product_types/:name", wrapper.PutProductTypesName)
 	router.POST(baseURL+"/products", wrapper.PostProducts)
 	router.GET(baseURL+"/pvz", wrapper.GetPvz)
 	router.POST(baseURL+"/pvz", wrapper.PostPvz)
+	router.DELETE(baseURL+"/pvz/:pvzId", wrapper.DeletePvzPvzId)
+	router.GET(baseURL+"/pvz/:pvzId", wrapper.GetPvzPvzId)
+	router.PATCH(baseURL+"/pvz/:pvzId", wrapper.PatchPvzPvzId)
 	router.POST(baseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
 	router.POST(baseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	router.GET(baseURL+"/pvz/:pvzId/events", wrapper.GetPvzPvzIdEvents)
+	router.GET(baseURL+"/pvz/:pvzId/receptions", wrapper.GetPvzPvzIdReceptions)
+	router.GET(baseURL+"/pvz/:pvzId/receptions/current", wrapper.GetPvzPvzIdReceptionsCurrent)
+	router.GET(baseURL+"/pvz/:pvzId/staff", wrapper.GetPvzPvzIdStaff)
+	router.POST(baseURL+"/pvz/:pvzId/staff", wrapper.PostPvzPvzIdStaff)
+	router.DELETE(baseURL+"/pvz/:pvzId/staff/:userId", wrapper.DeletePvzPvzIdStaffUserId)
+	router.GET(baseURL+"/readyz", wrapper.GetReadyz)
 	router.POST(baseURL+"/receptions", wrapper.PostReceptions)
+	router.GET(baseURL+"/receptions/:receptionId", wrapper.GetReceptionsReceptionId)
+	router.POST(baseURL+"/receptions/:receptionId/cancel", wrapper.PostReceptionsReceptionIdCancel)
+	router.POST(baseURL+"/receptions/:receptionId/products:batch", wrapper.PostReceptionsReceptionIdProductsBatch)
+	router.POST(baseURL+"/receptions/:receptionId/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	router.GET(baseURL+"/receptions/:receptionId/transitions", wrapper.GetReceptionsReceptionIdTransitions)
+	router.POST(baseURL+"/receptions/:receptionId/verify", wrapper.PostReceptionsReceptionIdVerify)
 	router.POST(baseURL+"/register", wrapper.PostRegister)
+	router.GET(baseURL+"/roles", wrapper.GetRoles)
+	router.PUT(baseURL+"/roles/:role", wrapper.PutRolesRole)
+	router.POST(baseURL+"/token/refresh", wrapper.PostTokenRefresh)
+	router.GET(baseURL+"/users", wrapper.GetUsers)
+	router.POST(baseURL+"/users", wrapper.PostUsers)
+	router.PATCH(baseURL+"/users/:userId", wrapper.PatchUsersUserId)
+	router.GET(baseURL+"/version", wrapper.GetVersion)
 
 }
@@ -18,34 +18,108 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZ724TSRJ/lVHffeCkASfHffK3u+M4cUK6iONYCRShwe44A54/9LSza5Cl2N6FRWSX",
-	"1QoJCS1iWV5gcGJinHjyCtVvtKrqGXvGHsdOYmUNn2zPVHdXVf+q6lflx6zkOb7nclcGrPiYBaVN7lj0",
-	"9V9CeAK/+MLzuZA2p8cODwKrwvGrrPucFVkghe1WWKNhMsEf1mzBy6x4Zyi4biaC3r37vCRZw2Rrt25P",
-	"7lyyZR0/uVtzcAP4BSLVhD50IGQmg3cQwgD6qnUR3kJXtaCrtuGDaqtt2MX3ryGEfZRRO6lDE+1MZpdx",
-	"9w1POJZkRVar2WWWIyZ4xQ6ksKTtuVcsyTOLypbkF6Xt8MmVY+aTNbm2C69cK8lJ+3Hvm7j1nAeewKIS",
-	"99Gca/PJ6weji1A/wAF00fNqGyIYQA/6+koi2IMufIS95OcH1YZOrv/H3ENvs6rlOetG8v4c3eVvPZrT",
-	"UYG0ZC1Iu8p27/rCqwgeBMxkpaoX8Nm+GFqSnD3cOc8lN70H3M0JP5P9P+A5Acsdy65mzNFPzoAnr5rB",
-	"B3f8qlfnqL/jlbmwpCdmW51oQbtNGoru5aWasGX9f5iUtDH3uCW4+HtNbo5+XU30/c9XN9F1JM2K8duR",
-	"AZtS+qyBG9vuhkcQ4kFJ2DG+MMFgRulATzUN2IMD9cJQbThS2xBCh0JgAD31woC38DO8MqBn0MsedOEQ",
-	"+hDBJ0O1IMJ8RYHSwbNtWSVlrNID7paNgIstu4Su2uIi0AevXlq5tIKO9XzuWr7NiuwyPTKZb8lNMrxQ",
-	"rjlO/bpXsXUoeAFlELxoKwlttuYF8spITvubB/IfXpkya8lzJXdpoeX7VbtESwv3Ax1fOvlPImgx9z3t",
-	"njNiUtQ4PQh8zw308X9dWTmR8n8WfIMV2Z8Ko9JWiOtaQQcPHTp2+e9VE46gq76HAYR4ySF08Dbpgvch",
-	"VE/w7vGW/rZAfXSRzdPnDXShQ4AcqOfwyaDShnCLVFNHR81xLFFH2bcQwYFqq6caotA1qDo2YzRGsAuR",
-	"hmafJELaoFCdjabFAukEqci3guBrT5Rn84xki+GKLwNjq+eOsa6hIaRa8U8s6zDQP8Yh91Oe5gYcERJ3",
-	"YD9Ogy3oYh7VePM18wmOh9xaIrUo1M1fz8+R+GilTgfVxUEj4aJ54PgtqWSIgwg+jIrgciRBA3pwgDV4",
-	"gJDFWOqrFvSgAwMqxZna3NM6Xz4HnV+icqqFzGGkb1c9055L0RpWvJMlNHfWG+uZIHuZ9XuS2ROGERrQ",
-	"oUwPfdVWz1Rb/ZixWrWNCySOEdmHaEhqmhAhpFUb9mJQR9CJac1f4ljdeoQuqPCcKP03l2tbjyjlCsvh",
-	"kouAbJm4vFA9hZBOj/PdHqWEEL/00DPUsUVUkZCVsSJ7WOOizkzmWo4OIktI6sLM1MXM145NKPSajuqq",
-	"p6dWh7vlRSnzBiI4RGgbhJZtSrU99UQ9n3K2j810+uAy37BqVcmKqyZzbNd2MGmtDs+2XckrXEz1xAH0",
-	"KNsjS+ggP9DJ7hCRpkGGoRWOqQfdKepVbceWU/RbMZljfaMVvLwyQ9v1M5ZmW3InyK0CM7PhrduZdjk4",
-	"brtULRuKzJVqhyZbQlj1zIGz9hh1w41GTmeY3XcOicnk9Q6OsP1BphjngxOmrBwq2oz37GOk6dZJNQ31",
-	"LeZvtaPBhfwBupS1dZ4i6jCWw3XHBSHsQo9COF5EfHE6n6BUdVoqMRMv51ywkyPH7i1xK0Swr2nbsnQq",
-	"n2HZfTfyIiE49m5uLYVDzQUJxJoQR9AZFdHCY2J6jQLNgu5WrUDezcT7scBdw7X/xJXXrUCOwn+i9FJG",
-	"9i25maoX8SgpC87cypXPh8+ciedNZTlwToV9qK+zr7bVc6zWS8Y+jzKqqjZ8RMTkaPyZBcGrlAUUBEc0",
-	"jUeKgKSRcnWkWkOZSco9Ngwjsoo8gjylvkvXl0yklHmVyzhU/NSwfGagXKGFGClJsf1D42RqP0W8O1ym",
-	"Xsqcs4sa67nGL3g4Mx2aN5pnfGbwf5+2IQ/+u7oGZBu0QXrWNmzSerCfatNimpNx64Xr167+1zRO26xl",
-	"Gev0QLkxkjvv4crYFGQ5xh8nKUJpbrV0RYi6OLVDcZmtPTSUSxvyZTCyQTzYnlVzLpw+pCp2IOP/044J",
-	"qFhquSbki/6LbniUeZZ/cRYXt/RHZ34blDd+3lnKxig7T/+VSkovGbbMnqc3Gr8HAAD//82HIsO8IQAA",
+	"H4sIAAAAAAAC/+x9W3PbRpbwX0Hh+x7sLdiSJ5mpWk3Ng2NnJs5lorKdZHdilwsmWxIiEuAAoGzZpSpd",
+	"kjgpea3dTKqSmqqMk5mXfaQU0aIupP5C9z/aOqe7gW6gQYISTUs2X2wRaPTl9Lmf06cf25Wg3gh84seR",
+	"PfPYjioLpO7in9fc2K0F8+/6cbgMvxth0CBh7BF861Zib4nAX1USVUKvEXuBb8/Y9Cfapi16wNZph+7Q",
+	"Lu3RtkX3aJe22BPapl3agQdd2qaH7CndY1sW7bA1ekx7/AHt0R3aYuvsqUV36SG8h0522Cb7Cjpq0WP8",
+	"oE33bceOlxvEnrHvB0GNuL694ti+W8dpiTdRHHr+vL2y4tgh+WvTC0nVnvmct3LkKu4mHQX3vyCVGPq5",
+	"7tWJH3mBHxkW+Tfaotu0xVZph62zTYuti2mv0pZFdyx6RDv0kB7SDj2ibbYOz9lXtpMB4gLx5hfij+rw",
+	"d93zvXqzbs9cSSbj+TGZJyHMpkb8+XihTMsHXrVMwww8kv7TDpx0eib4vBuGQZjHizqJIne+xA7Ihqa+",
+	"3yNuLV7Id15ZIJVF/MutVj3YDLc2q7XIjOlkN+5n2qZ7bANQja0Dmln0gLboC7pLe3TfosdsFXeyzVbp",
+	"Ae04VrBo4VZ2YI/b9ICtwTc99g3t0G1oYhvmH8Vu3MT5EB/g/7kdLNqO3fTdJderufdr6rIL4CP6MIHn",
+	"/c8+MBBkbd4IgEq4ZHxOjE8Xvar5ebxsfO4bnzYjc+8PB6MFDMSnwbtxcGEFULiVB8MiWcb/vZjU8Y//",
+	"H5I5e8b+f1Mpo5sSXG4KALmSdO2GobucnxB0aBp/9tO/GDDU44DK8YseItYuINOeBfwOWALdoT32BHni",
+	"ATCOX2UzwEHbMWEzciw3JtWrsWGc79gqbdMjYJq7Gh9usa9ph3Ys+px+R3+wHXsuCOtubM/YVTcml2Kv",
+	"TkzjcWxI2jabXtXULCTzXhSHLkzjuhsT7aM+A2RAjcArAPW7S8Q3rfgX2qPbbBOX2RbLcyx6jBTcpru0",
+	"hfx3DUCyY3ExQ9tW1Y1d/IWcG4Afk4fxFIFRLkVxSNz6ZeiNt/aqd3ytMVtDLnFMW3IEi61ZXpW/EPNh",
+	"W046IPZsXYJmsLzLd/ycNHArcRDeqBoW+TwrHLn0dOQ82mwVGdIO/rvPN38fWBXdAbjYzuA9lJibfxGS",
+	"FN+GQZssbtIe3UNBuIas9xn7lk8WxDtIydU89DTNgG2oW/mhG8WXECsu3biuLtDz49+9bZtEYyMMqs1K",
+	"bATxP8UoB7Qn9Q51NnTfEl9f/rcy0BSNb+PzUw6W73zp0Y2yhFkhOGrJ9rGYrxRbyfeXgwbxCXyTPqrU",
+	"gij7yPUrpFbLPF0ioTfn4UO5Lrda1X5XSY3EpDpYLKbzlnAQqKsvVkXbAo7ymRcv3JSfRHlW3lh6NEh+",
+	"gAhQoaxLnkx3fKXlhdMs/yAvoJQBB/WRrM9eSbtJodBX8MH6tbUZ4SgmmVvufTesBFUT8v8vqsMd9hWg",
+	"P911LLYhJSBwStrljFqw7zY9BNWZP+iAcBNKV919+CEqrfbM7952CtnWO8tmoQGTYBt0lw/tyP67bCvh",
+	"oqlSX4bigSHe9uqkPJcUOD9IkIPUOQK4sM0MFDSzw7Fom63RQ+2xhUsUcLWd4SZWFnTJGJ2Tgk4ztfrh",
+	"s2KUDaOdDMcEo8UmtBuEYHERb+/Q44xB2EfnQ35/XKTvPUAL7E+hW4+GNOgEi1TX3od+33HjysKNmNQL",
+	"hBVHJq5AtegByGEuti3UVbdxWYfCvO/YzlljBifFsNcHFQZt/k0SNQKf22365oUkatZikxckZ0yzTb5d",
+	"YMawLboLmKKuvwdvd4QXB36DH8d2hpKHcrbN2mARJqdeYvHQXYkVGvAdeTRywx4YcIb9VoimlaMNIv0o",
+	"WZ0f6eIJyCTa4lIAiGKPtqTKyD0EfwgJLIkYWZnnV8nDAoNij3bAKGRbmRln9wesDIt2EQpb/fTqIRSa",
+	"vINEiGtkWWI9g3VBXJ3Tz1VyU9WUMqY6qq+l9YM9tKZX2WZqZSlciG2UsrGG1Elw00855vB6SUmxWt4K",
+	"SXdbX7Pn32uEwXxIosi6dKc5Pf0WsfiuJD+l6fD7zMoTsdBjX4PeA4gKXtcefQGEeMdP1SZ00T61LiS2",
+	"yUV1N9k622DPrEupyyABOv9MmeRFNNolxiovbMdOjCHVBJKzH4zKyS6lVk0ZrJ4VrlYdsxN2WoqvKjZC",
+	"3tLwycP4WjOMjCzq72yDrYLhyk134Ie7AE32LbgfLLDzUczBFnzNNjlCszW2gf+u0x1u0Vucwx3TnuyE",
+	"dg0doKQYwBBwvX0hdjt0/ciTHCFHgxxjWpbwUQByrNGWhny0MyLPjcDOVGlWxhyCmwzjmJkLg/qthBoH",
+	"UX6xJyUkbhT4g6QWbDjdZlt0L1252GtoZiQ5oT/mVaygcN4mD4GyUOXbQV6BBEvANzCrGOxnzpTXtRz5",
+	"xkmnZlxeUDNwC20HTS5+c0DNsRskrHtRlPN79F1FUCOzyXcDFTgRplNHKlqY0mt+w7R35KFbbwAkgNXO",
+	"cIwwiq1KoHvD3FrNdmw3irx5nySOp4G8XRlddmpaxK3YnZu7ip3Xha87w2XEwMMQvPzmnWXtmyKWQuqu",
+	"V9Na8ienEv/NiISlmuYdUDeqdvK9CWa3g0Vixlp8M+t6hhAledjwQhINA8aQzIUkWigeLpZv+iE//zxn",
+	"nuHTzBiOMkvTwj+JSDh89MmBaCdGMVCpPAL+/GsiYZ+oTiYwcFoWfoYupTXk5Wh/g1R+yp5Zwu16GiQq",
+	"68ERjCuzrh/RQZbMsa9tzRtpSQMFaCcni4OaYP8pCc1s5n7Tq1Wlrp0X10G97hmMzHkvtm69dxVmvo0b",
+	"dWCWgPOBMnDu7VLhu8zyZMNkQmrP+eUCHySVZujFy7cAjcVKiRuS8GozXkh//VHu4vuf3UZGB63tGfE2",
+	"XdFCHDfslRW0TucCoxYGWsEOBH+S6MhGsquK1Y3BPot2hD4h1LOe5n+U7hQvRo5/360sEr9qRSRc8irE",
+	"VgBnX7k8fXkaYAmxDrfh2TP2W/jIsRtuvIALn7r8gNRqlxb94IE/9cWDxejyF0IRmie4t4APrnQ22n8i",
+	"8WekVvsAmr//YDF6Pwo4qXNnC3b5m+lp+K8S+LFg+26jUfMq2MuU7J7zkBIB7VsctrmsnBbHLuAAh+wZ",
+	"Evs+395mve6Gy1x720AaB17QZZu0nbbuyK3IpkhY739227oAA1/E7qbcat3zpyqBP+fN9wPMVWh3jTc7",
+	"JUjM2SBx2CR5fM7D5u+0R7vsS9qhv4JBw2PlbEuxSCSCcY1d4h/7krboPj0Uiu4BGu8vktfANb+Et+AV",
+	"ojuAWG9PvzWyreb5N6b1fA/TBjOCHqeunDYaZV2NoO2Zz3VS/vzuyl0NIb5P48jCsGvByg4KIMbWBOm2",
+	"eUQCfgNcMG67CT+lYb1pXUj5tMWRZSYkblUgUcWTTLUIfa7xFqfEnFJKq5YJl1dZ8zvwS14GDQl4Qw/Z",
+	"9BAFTlOPQVFeQYnUNIBrtinA9WehT7uhWycxCSOciQeTBhZnS22f/6cKDk5MKeAUl/hvp/Mi9S7/lkTx",
+	"O0F1eagtKcozzKb6ZeRaYSrfykp2HSsvkQPruGLAjR8yqZDoP/lKKGHwsMc5xfQYOAXkanJiBW6/rzl+",
+	"zym/ygaj2irZtGQuH6iKKjeHZlrSKkyIdnQmxXd2pu767jy5aKA/nj6kcq18KM0CCeFY7AmoKNZUY+nR",
+	"1GM0dOTXTiJod5CdfpXoOco6Llsq1HicTPpUxITbd3wwb4GnCgfbDjpKnyodJeDg77kTFUfj+UkFTBe4",
+	"yLt8qePgJU4WjjeqeW8hRF5EqtCGoK4kIKNlFf1eajDHbIPjmFR1FHEve9Q/teg2uOfBf8l985pOipYT",
+	"l39gW6ESj9BYIG4VFWABj2zmUgqIgZ63lbsDWVcuj608iSZpdiYqfS7T37KpShdukXCJhJduQYIbR4uL",
+	"auxCBM3Ek0T5FErSASAg/MTg2ITznZTzFW5PPzbCeVi1Wa8vfxjMe9ykDaK4ZMoaID9m9NFtsbkdSyRK",
+	"w7JAS0FuZmRU1z/56KP/vPfhx3+68ec/AEO4nOM3s0EUX0/nNiqNQjoTpFeP1Bu1YJkQ27HrQRUmEISD",
+	"nXoF3oHxKhvSoZTHrH9h4mSbfYPaxhZItx3u90HRJxT2s0FviRRSk3a7vAmYTodobgJq36iSeiOIiV9Z",
+	"vvQBWc64s7DXXSnkuoKl0118tkpf8ERomUOas3012dHhiQsJHqux/QNsIYhnAY8qPOpnqbwnmrxEROBD",
+	"mPm2GiTlTBg24gmHRN4DoLVGmFkXat4S8SEAytdcy/KKPN2OlmSHcUu7UfQgCKuDHWGyi+SLM0HN6LKe",
+	"UPTJKBrWfmXsa29bnG2wdfGTL5f/yBLYf5t2zcoff8No6VZCb0EzHkhw0GZkQrJ/wGPFQCp50ni7QI84",
+	"kOoy7jTdw03sss2zpP6NC410Ku5xUAyp+30HZyO100UdlFuQQLxpSa6/B2lEmLOpSjFdPwMD2RJ7fykv",
+	"7ER0+R7sfV/n3Gx6/OGNctGlKZzZEEQefiWcdioYJ667ievuTXPdCXJq5XJqR+rDU9N5igVskg40KvI4",
+	"t0nxQyR+vkYnKfiqT8ajRqdMJJlkBmJUDmpkktTPmFXQ5VmfGrFmcptp56wwMZjFv49hFunusTXAZTgx",
+	"0AFl7RudG3ANDgPNFvdPsKcJP5DQa4+G+WqnAkT9gw32LWZMZ/KxL7B1YcJkDrXqSe38CAh6IiX3Xerr",
+	"PJldepTXevLJDejPEOml/PQ72FCo3XZgR/HkRA/eSq/8X5skXE61pih2w/g6zwQ0OOT7HmJ/bMwoQCfL",
+	"SadD/OqoJvOTcrxaT8guGLvhzusDV8mci+djrjgDGKcREjyfpC2Oo/cs9l+IZUfC648YIXOuM+nepunV",
+	"PJ61ZJrfNEo9PsG3poee7XN+vF+YSrwIjXqCUpr7CWUUwtDzK7VmlVwXx5qNs51zaxHJl7ApEeMpaTBN",
+	"zjMPamG0C8F6RucU51PDB2KyfuQ10SdoKbxP5PGQP4MHy2QGDR56ABVGMox29sAv7fDDZL/yBHv5EWpl",
+	"xZrr0qNTKK0D8WXMCtCnfzHumwQr+pN2aUuK7oktdgJb7JcUikpZFbOMx0NX3B/bEp6sHt1JhbtMb+Cs",
+	"D9hhHks5m5xdejQrUr4Hezlkcnixm2NQsvndUv5KvvJMNZ0OX2SKZWdDTX17DLMQ8OjyLAnIf9zlFqko",
+	"kiUU0wHwGodC/Z1E21RLBk+BcvKP62Yqh22dID8yU2QpTUxWHQ+QisOx/yLmchdru+MkgddVAxogQDKV",
+	"XwrRfELbuyfLRMkoQKnOoxMbPeIqjWppwjMex40rCwZ9Bh6/CkExCo/fSy0Ot/KKvef9FTPdWTtRzc6g",
+	"6O4ns4diAD/m/fJmgdhsgCcjrypO4WH6ezU3iu9ppmFfGwdZwjX4EhIsb6qHYl+JNjk69FatXnOCT8JS",
+	"LV2/OWvpINpUpa5omPE5s5d+UFbQ0VKJk1oG2hn3vLfbUCVHSMMDHtxJXREapXCdkpOKUoZlMKFwvQwo",
+	"RfplXimdFDvDFeXzbGCzUzKAkQl3ZDc4OdiYLC/N3zpn6P8vdQ0m9P+Vuwv0GENxvaQ00kDbebBe+PDG",
+	"Hz92rFPEGzInLorPa/yiZf9ni2E6VrYWpvZE1oFx7vj5UpiOpVXCBC04UwoTWcCXqPMdcWfgHT+faQ9F",
+	"avVaGjxBTsuzx/Pbd/xM+t0BRpK0wwgAwK4MpsiqU8khB7bFnsn6q+YjFPpxiYIzJJIDDXGC5CVYupMz",
+	"JJMzJBO3b9kzJKr+rkUjVEeQgcHqkaRBXi/FJ/Oq/F+/pCWY8gk1BXFjXlkoHXVUBcImce6hJpPNzktL",
+	"lyW5UmwTOa+5PFnBDCvYhTbBcoAZX9j7N2rY+8r0oLj3WExVrExnDLBqq9VKq/Ww6KG47YVLU1UUni1b",
+	"9iAtfncumf2P/GgTAF+PgqSR735Rah0CiQFkik0XC4WpSjMMBbjKC4dr4qPXyLGj1Zszbfw/FO+BKWr1",
+	"Znkyf+L2d3+XypD08M/E5myxLb3vPMBVAskFL7L4HkFhtzIojhXgzglal0r5yZa0K5eAkzXhk1trzmcq",
+	"RZpPZHZPpNVo0XpPwqziurOTJV84JVyAY0e3UcTQTlpMsLCI4HjTl3IUUYYCDAhyZlyhg4JK5oON4jQn",
+	"XLYBeJzc62OkkKPzHBNQaDo5MqQvsHUKMjeKmqnHHNmHSLlCtPwEvxqf6W3otymn8LLTugw0JiR+lsbe",
+	"HK3KAJOuEp9LAaMi7JBU8Q+2XpoqemxdDHICqgiJW13ue7LgJm/xaqoy/E242rAs4G+n3xrHmD/JYljC",
+	"+SA4dI/XHadd4YbuWyUjmbc8YAesXFydhAUSRcoYtxa/RCy6QP+Hfu/Io/vJBLSEMlWqYKX4IywuKE3O",
+	"TrKr2n1dhSqO5kkcjeJR9tSbsYjxq1Y7hskgUHOoz1wGwVCpnK9D5nVXlMoYlDBw4vNXijfmsXJb1Ep/",
+	"1im/uanduTdYcdDv6Jtkrr4cb9HzN9k5pHOznGUydI63IZ81Q4lFTqAi0priIaCyIkyhsWv8w3FS2plJ",
+	"bNtJmeGRfj9Le4LiORQf1ymL5zztRNaf6WIyRabgXy6nh6dF9LthZ3irQt7ckyFO0z1Rah5qQiYznCih",
+	"2MKp5GZSTmLmvkxh71NSUUgiLTtKzbORF8vtW0nYjGs9SSAmd9+eqjdhEW48Sy4rzuoJZ5BUZEyD0ZKy",
+	"lBKP/K5rFLQHeoF0uBMfd/OJmjRzICJ9SS4xJgZZ2toxN6mraX9HqetoC37wnDtAJFHFlpfaOkqLPhxp",
+	"HidZU0k0Mh2hl6kkWMo8gTgHB3+5m9xYJEqJOKmxhM9lsS/Dzl226M8Y/OYnr9UbAA2XbuD6WyIQ3KP7",
+	"4haDd67evvbevY+u/se92ZsfX//k2u1bppSqQmEhVQS84nD8MmMkRtcJDxmn95muYDD8Bv/2yqArj4tv",
+	"TxrzYQrTzZwm9mu8ghMxGCjvBcd1tqHQM9uYZEi96TLbMBWDmJRHOjPcM5fJi9wNGdohPpC88UgXRdKR",
+	"f2Uce/5c8lsLBBeSxze0beCoQxsD6vXHbdP1x8YbbwdfXjlyA34qJEGD+CewMm7yD8+lxEhvJtTK3PGc",
+	"KPn7ysDqzryXVy0GRmsfjYnjg4opbipWFHJ5J+SE7b/Zptpz45WfmVNgJnfnmo5X+EbDrcydQqlhx/kg",
+	"GnYDQkYFjDRO7oyNhvaK3la+PU9um+GuDk5XWSrHR8NEeTGwRLNVnvpykGaCHk2YxulcmHqup7x6mG1K",
+	"kHPK7tEdE20X0wVmry+fQMH4lH84cWNO3JgT2ahHGnbF/Qyr9IWAQhnpaBZ8nD7LCL55L4pJOIiSRauz",
+	"dauDM6ILXXLXQTinueNldKF7vOm34DCdMbPu7NVAOxsXvPyMFNuRx21K3bwAGNBf58MGY9G1ABnLKFc/",
+	"87NH5z9bOrmyGU+bdGSJ6xZkO6j8LqgR9epQ3LOpx/Bf3+L6uHXwTzk9hDcsVkBemkdj0P38Y7iGv//9",
+	"+2P2iSAdFOE9e5qvyt86e+Wv9UgW9yQmGP1aJC6pt7Ljf3uYGSFitG3a7kPMWnV+vB5/StxJ0l9Dwdtq",
+	"boqW47sJR3ccKq3P9oVSPwmBt5X479WLYZIbqyd3Sb3Utd/MXbYjrY/k5m3a4UoJvHSs5G6fg2zNzW22",
+	"SQ/xfVapkdSsZEzQbb7J2bBF0bVAx2hZZOfKNjiRQt5+Xz3pE2wwuJD7G1OqfMxntktpBdzUGLZItlGB",
+	"llWpz7UGWrw0TWYB8ksFtN+5O0kDoy0mmdMHR21WT8zmV3e6ruj0nGD5bI17oji/Yhs84C2kwir/ttiv",
+	"dt5T4wssd6wze8ieYeB/P4EDe5YnWk3RhCeRdnauXw1aJOYhTsyN6mTbS6xD+5xtCG/mlpXkookTIeJw",
+	"D0cw7nvcYVt0j6czHCjVadmGidMUM5NXqiEPzSXOYh3b80Tx46t12+fY8SmKW+dr2yqGrna3ejF/6s+G",
+	"lkgYCVdPkTL9qWjyEglDDmEsrA94iJeM5nysyivgGtsIDh4IWVn5vwEAGpiFNmyuAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,63 @@
+// Package cli is the pvz-service command tree. Every command reads the shared
+// configuration through config.Load, so the environment, .env and the
+// -db-* flags work the same everywhere.
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const usage = `Usage: pvz-service [command] [flags] [args]
+
+Commands:
+  serve                                  run the HTTP server (default)
+  migrate up | down N | status           apply, revert or list schema migrations
+  user create -email E -role R           create a user, e.g. the first moderator
+  pvz import FILE                        import PVZs from a .csv or .json file, - for stdin
+  seed -pvz N -receptions N [-force]     fill the database with test data
+
+Run "pvz-service <command> -h" for the flags of a command.
+`
+
+// Run dispatches args (without the program name) to a command. Without a
+// command, or with only flags, it runs serve as before.
+func Run(args []string) error {
+	err := run(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	return err
+}
+
+func run(args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") && args[0] != "-h" && args[0] != "--help" {
+		return serve(args)
+	}
+
+	switch args[0] {
+	case "serve":
+		return serve(args[1:])
+	case "migrate":
+		return migrateCmd(args[1:])
+	case "user":
+		return userCmd(args[1:])
+	case "pvz":
+		return pvzCmd(args[1:])
+	case "seed":
+		return seed(args[1:])
+	case "help", "-h", "--help":
+		fmt.Fprint(os.Stdout, usage)
+		return nil
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet(name, flag.ContinueOnError)
+}
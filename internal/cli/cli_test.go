@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/internal/testdb"
+)
+
+// Every case here fails before a database is opened.
+func TestArgs(t *testing.T) {
+	// .env may turn it on, which production does not allow
+	t.Setenv("DUMMY_LOGIN", "")
+
+	for _, tc := range []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"help", []string{"help"}, ""},
+		{"unknown command", []string{"frobnicate"}, `unknown command "frobnicate"`},
+
+		{"migrate without command", []string{"migrate"}, "usage: migrate"},
+		{"migrate unknown command", []string{"migrate", "sideways"}, `unknown migrate command "sideways"`},
+		{"migrate down without N", []string{"migrate", "down"}, "usage: migrate down N"},
+		{"migrate down zero", []string{"migrate", "down", "0"}, "N must be a positive number"},
+		{"migrate down not a number", []string{"migrate", "down", "all"}, "N must be a positive number"},
+		{"migrate unknown flag", []string{"migrate", "-nope", "up"}, "flag provided but not defined: -nope"},
+		{"migrate help", []string{"migrate", "-h"}, ""},
+
+		{"seed no pvz", []string{"seed", "-pvz", "0"}, "-pvz must be positive"},
+		{"seed negative products", []string{"seed", "-products", "-1"}, "-products not negative"},
+		{"seed bad number", []string{"seed", "-receptions", "many"}, "invalid value"},
+		{"seed in production", []string{"seed", "-env", "production"}, "pass -force"},
+		{"seed in production forced", []string{"seed", "-env", "production", "--force", "-pvz", "0"}, "-pvz must be positive"},
+		{"seed help", []string{"seed", "-h"}, ""},
+
+		{"user without command", []string{"user"}, "usage: user create"},
+		{"user unknown command", []string{"user", "delete"}, "usage: user create"},
+		{"user create without email", []string{"user", "create", "-role", "moderator"}, "-email is required"},
+		{"user create flag without value", []string{"user", "create", "-email"}, "flag needs an argument: -email"},
+		{"user create help", []string{"user", "create", "-h"}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Run(tc.args)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommands(t *testing.T) {
+	t.Setenv("DUMMY_LOGIN", "")
+	db, cfg := testdb.New(t)
+	name := cfg.Name
+	run := func(args ...string) error { return Run(args) }
+	count := func(table string) int {
+		var n int
+		assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM `+table).Scan(&n))
+		return n
+	}
+
+	assert.NoError(t, run("migrate", "-db-name", name, "status"))
+	if !assert.NoError(t, run("migrate", "-db-name", name, "up")) {
+		return
+	}
+	assert.NoError(t, run("migrate", "-db-name", name, "status"))
+	assert.NoError(t, run("migrate", "-db-name", name, "down", "1"))
+	assert.NoError(t, run("migrate", "-db-name", name, "up"))
+
+	t.Run("user create", func(t *testing.T) {
+		assert.NoError(t, run("user", "create", "-email", "admin@example.com", "-role", "moderator", "-db-name", name))
+		assert.ErrorContains(t, run("user", "create", "-email", "admin@example.com", "-role", "moderator", "-db-name", name), "user create")
+		assert.ErrorContains(t, run("user", "create", "-email", "other@example.com", "-role", "overlord", "-db-name", name), `unknown role "overlord"`)
+
+		var role string
+		assert.NoError(t, db.QueryRow(`SELECT role FROM users WHERE email = 'admin@example.com'`).Scan(&role))
+		assert.Equal(t, "moderator", role)
+	})
+
+	t.Run("seed", func(t *testing.T) {
+		assert.NoError(t, run("seed", "-pvz", "2", "-receptions", "3", "-products", "2", "-db-name", name))
+		assert.Equal(t, 2, count("pvz"))
+		assert.Equal(t, 3, count("receptions"))
+		assert.Equal(t, 6, count("products"))
+
+		assert.ErrorContains(t, run("seed", "-env", "production", "-pvz", "1", "-db-name", name), "pass -force")
+		assert.Equal(t, 2, count("pvz"))
+		assert.NoError(t, run("seed", "-env", "production", "-force", "-pvz", "1", "-receptions", "1", "-products", "0", "-db-name", name))
+		assert.Equal(t, 3, count("pvz"))
+	})
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/wisp167/pvz/internal/config"
+	"github.com/wisp167/pvz/internal/migrate"
+	"github.com/wisp167/pvz/internal/server"
+)
+
+// migrateCmd runs migrate [flags] up | down N | status.
+func migrateCmd(args []string) error {
+	fs := newFlagSet("migrate")
+	cfg, err := config.Load(fs, args)
+	if err != nil {
+		return err
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate [flags] up | down N | status")
+	}
+	// check the arguments before connecting, so a typo does not wait on the database
+	var steps int
+	switch args[0] {
+	case "up", "status":
+	case "down":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: migrate down N")
+		}
+		steps, err = strconv.Atoi(args[1])
+		if err != nil || steps < 1 {
+			return fmt.Errorf("migrate down: N must be a positive number, got %q", args[1])
+		}
+	default:
+		return fmt.Errorf("unknown migrate command %q, want up, down or status", args[0])
+	}
+
+	db, err := server.OpenDB(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	migrator, err := migrate.New(db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+	case "down":
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %04d_%s\n", m.Version, m.Name)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if errors.Is(err, migrate.ErrNotInitialized) {
+			fmt.Println("schema_migrations is not initialized, run migrate up")
+		} else if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			name, appliedAt := s.Name, "pending"
+			if name == "" {
+				name = "(unknown to this binary)"
+			}
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, name, appliedAt)
+		}
+		return w.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/config"
+	"github.com/wisp167/pvz/internal/logging"
+	"github.com/wisp167/pvz/internal/server"
+)
+
+func pvzCmd(args []string) error {
+	if len(args) == 0 || args[0] != "import" {
+		return fmt.Errorf("usage: pvz import [flags] FILE")
+	}
+	return pvzImport(args[1:])
+}
+
+// pvzImport reads PVZs from a JSON array in the format of POST /pvz or from a
+// CSV file with a header row: city is required, id and registrationDate
+// (RFC 3339) are optional.
+func pvzImport(args []string) error {
+	fs := newFlagSet("pvz import")
+	format := fs.String("format", "", "csv or json; by default taken from the file extension")
+
+	cfg, err := config.Load(fs, args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: pvz import [flags] FILE")
+	}
+
+	path := fs.Arg(0)
+	in := os.Stdin
+	if path != "-" {
+		in, err = os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+	}
+	if *format == "" {
+		*format = "csv"
+		if strings.HasSuffix(strings.ToLower(path), ".json") {
+			*format = "json"
+		}
+	}
+
+	var pvzs []api.PVZ
+	switch *format {
+	case "csv":
+		pvzs, err = readPVZCSV(in)
+	case "json":
+		err = json.NewDecoder(in).Decode(&pvzs)
+	default:
+		return fmt.Errorf("pvz import: unknown format %q", *format)
+	}
+	if err != nil {
+		return fmt.Errorf("pvz import: %w", err)
+	}
+
+	model, err := server.OpenModels(cfg, logging.New(os.Stderr, cfg.Log, cfg.Env))
+	if err != nil {
+		return err
+	}
+	defer model.Close(context.Background())
+
+	imported, err := model.ImportPVZs(context.Background(), pvzs)
+	if err != nil {
+		return fmt.Errorf("pvz import: %w", err)
+	}
+	fmt.Printf("imported %d of %d pvz, %d already existed\n", len(imported), len(pvzs), len(pvzs)-len(imported))
+	return nil
+}
+
+func readPVZCSV(in io.Reader) ([]api.PVZ, error) {
+	r := csv.NewReader(in)
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["city"]; !ok {
+		return nil, errors.New("csv header has no city column")
+	}
+
+	var pvzs []api.PVZ
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return pvzs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		pvz := api.PVZ{City: record[columns["city"]]}
+		if i, ok := columns["id"]; ok && record[i] != "" {
+			id, err := uuid.Parse(record[i])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid id: %w", line, err)
+			}
+			pvz.Id = &id
+		}
+		if i, ok := columns["registrationDate"]; ok && record[i] != "" {
+			date, err := time.Parse(time.RFC3339, record[i])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid registrationDate: %w", line, err)
+			}
+			pvz.RegistrationDate = &date
+		}
+		pvzs = append(pvzs, pvz)
+	}
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/config"
+	"github.com/wisp167/pvz/internal/logging"
+	"github.com/wisp167/pvz/internal/server"
+)
+
+// seed fills the database with closed receptions spread over new PVZs in the
+// active cities. Everything is recorded as done by the dummy employee, which
+// is created if needed, so in production seed refuses to run without -force.
+func seed(args []string) error {
+	fs := newFlagSet("seed")
+	pvzCount := fs.Int("pvz", 10, "Number of PVZs to create")
+	receptionCount := fs.Int("receptions", 100, "Number of receptions, spread evenly over the PVZs")
+	productCount := fs.Int("products", 10, "Products per reception")
+	force := fs.Bool("force", false, "Seed even when env is production")
+
+	cfg, err := config.Load(fs, args)
+	if err != nil {
+		return err
+	}
+	if cfg.Env == "production" && !*force {
+		return fmt.Errorf("seed: refusing to add test data and the dummy user in production, pass -force to do it anyway")
+	}
+	if *pvzCount < 1 || *receptionCount < 0 || *productCount < 0 {
+		return fmt.Errorf("seed: -pvz must be positive, -receptions and -products not negative")
+	}
+
+	model, err := server.OpenModels(cfg, logging.New(os.Stderr, cfg.Log, cfg.Env))
+	if err != nil {
+		return err
+	}
+	defer model.Close(context.Background())
+
+	ctx := context.Background()
+
+	cityRows, err := model.ListCities(ctx)
+	if err != nil {
+		return err
+	}
+	var cities []string
+	for _, city := range cityRows {
+		if city.Active {
+			cities = append(cities, city.Name)
+		}
+	}
+	typeRows, err := model.ListProductTypes(ctx)
+	if err != nil {
+		return err
+	}
+	var productTypes []string
+	for _, productType := range typeRows {
+		if productType.Active {
+			productTypes = append(productTypes, productType.Name)
+		}
+	}
+	if len(cities) == 0 || len(productTypes) == 0 {
+		return fmt.Errorf("seed: need at least one active city and product type")
+	}
+
+	user, err := model.DummyUser(ctx, "employee")
+	if err != nil {
+		return err
+	}
+
+	pvzIDs := make([]uuid.UUID, 0, *pvzCount)
+	for range *pvzCount {
+		pvz, err := model.AddPVZ(ctx, api.PVZ{City: cities[rand.IntN(len(cities))]})
+		if err != nil {
+			return fmt.Errorf("seed: %w", err)
+		}
+		pvzIDs = append(pvzIDs, pvz.ID)
+	}
+
+	for i := range *receptionCount {
+		pvzID := pvzIDs[i%len(pvzIDs)]
+
+		if _, err := model.AddReception(ctx, api.PostReceptionsJSONBody{PvzId: pvzID}, user.ID); err != nil {
+			return fmt.Errorf("seed: %w", err)
+		}
+		for range *productCount {
+			product := api.PostProductsJSONBody{PvzId: pvzID, Type: productTypes[rand.IntN(len(productTypes))]}
+			if _, err := model.AddProduct(ctx, product, user.ID); err != nil {
+				return fmt.Errorf("seed: %w", err)
+			}
+		}
+		if _, err := model.CloseLastReception(ctx, pvzID, user.ID); err != nil {
+			return fmt.Errorf("seed: %w", err)
+		}
+	}
+
+	fmt.Printf("created %d pvz, %d receptions, %d products\n", *pvzCount, *receptionCount, *receptionCount**productCount)
+	return nil
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/wisp167/pvz/internal/config"
+	"github.com/wisp167/pvz/internal/server"
+)
+
+func serve(args []string) error {
+	cfg, err := config.Load(newFlagSet("serve"), args)
+	if err != nil {
+		return err
+	}
+	app, err := server.NewApplication(cfg)
+	if err != nil {
+		return err
+	}
+
+	// kill (no param) default send syscall.SIGTERM
+	// kill -2 is syscall.SIGINT
+	// kill -9 is syscall. SIGKILL but can"t be catch, so don't need add it
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// kill -HUP reloads the JWT signing keys
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	go func() {
+		for range hup {
+			if err := app.ReloadKeys(); err != nil {
+				slog.Error("failed to reload signing keys", "error", err)
+			}
+		}
+	}()
+
+	if err := app.Run(ctx); err != nil {
+		return err
+	}
+	slog.Info("server exiting")
+	return nil
+}
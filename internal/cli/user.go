@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/config"
+	"github.com/wisp167/pvz/internal/helpers"
+	"github.com/wisp167/pvz/internal/logging"
+	"github.com/wisp167/pvz/internal/server"
+)
+
+func userCmd(args []string) error {
+	if len(args) == 0 || args[0] != "create" {
+		return fmt.Errorf("usage: user create -email E -role R [-password P] [-city C]")
+	}
+	return userCreate(args[1:])
+}
+
+// userCreate adds a user with any role directly in the database, which is how
+// the first moderator or admin gets in without /register.
+func userCreate(args []string) error {
+	fs := newFlagSet("user create")
+	email := fs.String("email", "", "Email to log in with (required)")
+	password := fs.String("password", "", "Password; a random one is generated and printed when empty")
+	role := fs.String("role", "employee", "Role from the roles table")
+	city := fs.String("city", "", "City for city-scoped roles such as regional_manager")
+
+	cfg, err := config.Load(fs, args)
+	if err != nil {
+		return err
+	}
+	if *email == "" {
+		return fmt.Errorf("user create: -email is required")
+	}
+
+	generated := *password == ""
+	if generated {
+		*password, _, err = helpers.NewOpaqueToken()
+		if err != nil {
+			return err
+		}
+	}
+
+	model, err := server.OpenModels(cfg, logging.New(os.Stderr, cfg.Log, cfg.Env))
+	if err != nil {
+		return err
+	}
+	defer model.Close(context.Background())
+
+	if !model.Policy.HasRole(*role) {
+		return fmt.Errorf("user create: unknown role %q", *role)
+	}
+
+	req := api.PostUsersJSONBody{
+		Email:    openapi_types.Email(*email),
+		Password: *password,
+		Role:     *role,
+	}
+	if *city != "" {
+		req.City = city
+	}
+
+	user, err := model.CreateUser(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("user create: %w", err)
+	}
+
+	fmt.Printf("created %s %s (%s)\n", user.Role, user.Email, user.ID)
+	if generated {
+		fmt.Printf("password: %s\n", *password)
+	}
+	return nil
+}
@@ -0,0 +1,461 @@
+// Package config loads the service configuration in layers: built-in
+// defaults, then an optional YAML file, then environment variables (and
+// .env), then command line flags. Each layer only overrides what it sets.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+type Config struct {
+	Port          int           `yaml:"port" json:"port"`
+	GRPCPort      int           `yaml:"grpc_port" json:"grpcPort"`
+	Env           string        `yaml:"env" json:"env"`
+	DB            DB            `yaml:"db" json:"db"`
+	JWT           JWT           `yaml:"jwt" json:"jwt"`
+	Tracing       Tracing       `yaml:"tracing" json:"tracing"`
+	Log           Log           `yaml:"log" json:"log"`
+	PolicyRefresh time.Duration `yaml:"policy_refresh" json:"policyRefresh"`
+	AutoMigrate   bool          `yaml:"auto_migrate" json:"autoMigrate"`
+	// DummyLogin serves POST /dummyLogin, which hands out employee and
+	// moderator tokens without a password; development and testing only
+	DummyLogin bool `yaml:"dummy_login" json:"dummyLogin"`
+	// IdempotencyTTL is how long a response is kept for replay under its Idempotency-Key
+	IdempotencyTTL time.Duration `yaml:"idempotency_ttl" json:"idempotencyTtl"`
+	// BatchMaxProducts caps the products accepted by one batch request
+	BatchMaxProducts int `yaml:"batch_max_products" json:"batchMaxProducts"`
+	// EventsRetention is how long streamed events are kept for clients resuming with Last-Event-ID
+	EventsRetention time.Duration `yaml:"events_retention" json:"eventsRetention"`
+	// ShutdownTimeout bounds draining requests and transactions on SIGTERM
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" json:"shutdownTimeout"`
+
+	// File is the config file the values were read from, if any.
+	File string `yaml:"-" json:"file,omitempty"`
+}
+
+type DB struct {
+	Host         string        `yaml:"host" json:"host"`
+	Port         int           `yaml:"port" json:"port"`
+	Name         string        `yaml:"name" json:"name"`
+	User         string        `yaml:"user" json:"user"`
+	Password     Secret        `yaml:"password" json:"password"`
+	SSLMode      string        `yaml:"sslmode" json:"sslMode"`
+	MaxOpenConns int           `yaml:"max_open_conns" json:"maxOpenConns"`
+	MaxIdleConns int           `yaml:"max_idle_conns" json:"maxIdleConns"`
+	MaxIdleTime  time.Duration `yaml:"max_idle_time" json:"maxIdleTime"`
+}
+
+type JWT struct {
+	KeysDir   string        `yaml:"keys_dir" json:"keysDir"`
+	ActiveKID string        `yaml:"active_kid" json:"activeKid"`
+	Issuer    string        `yaml:"issuer" json:"issuer"`
+	Audience  string        `yaml:"audience" json:"audience"`
+	ClockSkew time.Duration `yaml:"clock_skew" json:"clockSkew"`
+}
+
+// Tracing selects where OpenTelemetry spans go.
+type Tracing struct {
+	// Exporter is none, otlp or stdout
+	Exporter    string  `yaml:"exporter" json:"exporter"`
+	Endpoint    string  `yaml:"endpoint" json:"endpoint"`
+	SampleRatio float64 `yaml:"sample_ratio" json:"sampleRatio"`
+	ServiceName string  `yaml:"service_name" json:"serviceName"`
+}
+
+type Log struct {
+	// Level is debug, info, warn or error
+	Level string `yaml:"level" json:"level"`
+	// Format is json or text; empty means text in development and json elsewhere
+	Format string `yaml:"format" json:"format"`
+}
+
+// Secret is a string that never shows up in logs, flag help or JSON.
+type Secret string
+
+const redacted = "[redacted]"
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// Set makes a *Secret usable as a flag.Value.
+func (s *Secret) Set(value string) error {
+	*s = Secret(value)
+	return nil
+}
+
+func Default() Config {
+	return Config{
+		Port:     8080,
+		GRPCPort: 9090,
+		Env:      "development",
+		DB: DB{
+			Host:         "localhost",
+			Port:         5432,
+			Name:         "pvz",
+			User:         "postgres",
+			SSLMode:      "disable",
+			MaxOpenConns: 25,
+			MaxIdleConns: 25,
+			MaxIdleTime:  15 * time.Minute,
+		},
+		JWT: JWT{
+			Issuer:    "pvz",
+			Audience:  "pvz",
+			ClockSkew: 30 * time.Second,
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			Endpoint:    "http://localhost:4318",
+			SampleRatio: 1,
+			ServiceName: "pvz-service",
+		},
+		Log: Log{
+			Level: "info",
+		},
+		PolicyRefresh:    time.Minute,
+		IdempotencyTTL:   24 * time.Hour,
+		BatchMaxProducts: 500,
+		EventsRetention:  24 * time.Hour,
+		ShutdownTimeout:  15 * time.Second,
+	}
+}
+
+// Load builds the configuration for a command. The config flags are added to
+// fs, so a command can define its own flags on fs first; arguments after the
+// flags are left in fs.Args(). The file comes from -config or CONFIG_FILE.
+func Load(fs *flag.FlagSet, args []string) (Config, error) {
+	cfg := Default()
+
+	godotenv.Load(".env")
+
+	cfg.File = configFileArg(fs, args)
+	if cfg.File == "" {
+		cfg.File = os.Getenv("CONFIG_FILE")
+	}
+	if cfg.File != "" {
+		if err := cfg.loadFile(cfg.File); err != nil {
+			return cfg, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return cfg, err
+	}
+
+	cfg.registerFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// configFileArg finds -config before the flags are parsed, since the file
+// provides the defaults of the other flags. args go through a throwaway copy
+// of fs with the config flags added, so the value of another flag is never
+// taken for the end of the flags. A parse error is left for fs.Parse to report.
+func configFileArg(fs *flag.FlagSet, args []string) string {
+	probe := flag.NewFlagSet(fs.Name(), flag.ContinueOnError)
+	probe.SetOutput(io.Discard)
+	probe.Usage = func() {}
+
+	defaults := Default()
+	defaults.registerFlags(probe)
+	fs.VisitAll(func(f *flag.Flag) {
+		if probe.Lookup(f.Name) != nil {
+			return
+		}
+		b, ok := f.Value.(interface{ IsBoolFlag() bool })
+		probe.Var(ignoredValue(ok && b.IsBoolFlag()), f.Name, f.Usage)
+	})
+
+	probe.Parse(args)
+	return probe.Lookup("config").Value.String()
+}
+
+// ignoredValue stands in for a command's own flags in configFileArg; it only
+// needs to know whether the flag takes a value.
+type ignoredValue bool
+
+func (v ignoredValue) String() string   { return "" }
+func (v ignoredValue) Set(string) error { return nil }
+func (v ignoredValue) IsBoolFlag() bool { return bool(v) }
+
+func (cfg *Config) loadFile(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %v", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to parse config file %s: %v", path, err)
+	}
+	return nil
+}
+
+func (cfg *Config) loadEnv() error {
+	var errs []error
+
+	envString := func(key string, dst *string) {
+		if value, ok := os.LookupEnv(key); ok && value != "" {
+			*dst = strings.TrimSpace(value)
+		}
+	}
+	envInt := func(key string, dst *int) {
+		if value, ok := os.LookupEnv(key); ok && value != "" {
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse %s: %v", key, err))
+				return
+			}
+			*dst = n
+		}
+	}
+	envDuration := func(key string, dst *time.Duration) {
+		if value, ok := os.LookupEnv(key); ok && value != "" {
+			d, err := time.ParseDuration(strings.TrimSpace(value))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse %s: %v", key, err))
+				return
+			}
+			*dst = d
+		}
+	}
+	envFloat := func(key string, dst *float64) {
+		if value, ok := os.LookupEnv(key); ok && value != "" {
+			f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse %s: %v", key, err))
+				return
+			}
+			*dst = f
+		}
+	}
+	envBool := func(key string, dst *bool) {
+		if value, ok := os.LookupEnv(key); ok && value != "" {
+			b, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to parse %s: %v", key, err))
+				return
+			}
+			*dst = b
+		}
+	}
+
+	envInt("PORT", &cfg.Port)
+	envInt("GRPC_PORT", &cfg.GRPCPort)
+	envString("ENV", &cfg.Env)
+
+	envString("DATABASE_HOST", &cfg.DB.Host)
+	envInt("DATABASE_PORT", &cfg.DB.Port)
+	envString("DATABASE_NAME", &cfg.DB.Name)
+	envString("DATABASE_USER", &cfg.DB.User)
+	envString("DATABASE_PASSWORD", (*string)(&cfg.DB.Password))
+	envString("DATABASE_SSLMODE", &cfg.DB.SSLMode)
+	envInt("DATABASE_MAX_OPEN_CONNS", &cfg.DB.MaxOpenConns)
+	envInt("DATABASE_MAX_IDLE_CONNS", &cfg.DB.MaxIdleConns)
+	envDuration("DATABASE_MAX_IDLE_TIME", &cfg.DB.MaxIdleTime)
+
+	envString("JWT_KEYS_DIR", &cfg.JWT.KeysDir)
+	envString("JWT_ACTIVE_KID", &cfg.JWT.ActiveKID)
+	envString("JWT_ISSUER", &cfg.JWT.Issuer)
+	envString("JWT_AUDIENCE", &cfg.JWT.Audience)
+	envDuration("JWT_CLOCK_SKEW", &cfg.JWT.ClockSkew)
+
+	envString("TRACING_EXPORTER", &cfg.Tracing.Exporter)
+	envString("TRACING_ENDPOINT", &cfg.Tracing.Endpoint)
+	envFloat("TRACING_SAMPLE_RATIO", &cfg.Tracing.SampleRatio)
+	envString("TRACING_SERVICE_NAME", &cfg.Tracing.ServiceName)
+
+	envString("LOG_LEVEL", &cfg.Log.Level)
+	envString("LOG_FORMAT", &cfg.Log.Format)
+
+	envDuration("POLICY_REFRESH_INTERVAL", &cfg.PolicyRefresh)
+	envBool("AUTO_MIGRATE", &cfg.AutoMigrate)
+	envBool("DUMMY_LOGIN", &cfg.DummyLogin)
+	envDuration("IDEMPOTENCY_TTL", &cfg.IdempotencyTTL)
+	envDuration("EVENTS_RETENTION", &cfg.EventsRetention)
+	envInt("BATCH_MAX_PRODUCTS", &cfg.BatchMaxProducts)
+	envDuration("SHUTDOWN_TIMEOUT", &cfg.ShutdownTimeout)
+
+	return errors.Join(errs...)
+}
+
+// registerFlags uses the values loaded so far as the flag defaults.
+func (cfg *Config) registerFlags(fs *flag.FlagSet) {
+	fs.String("config", cfg.File, "YAML config file (env CONFIG_FILE)")
+
+	fs.IntVar(&cfg.Port, "port", cfg.Port, "API server port")
+	fs.IntVar(&cfg.GRPCPort, "grpc-port", cfg.GRPCPort, "gRPC server port, 0 to disable")
+	fs.StringVar(&cfg.Env, "env", cfg.Env, "Environment (development|testing|staging|production)")
+
+	fs.StringVar(&cfg.DB.Host, "db-host", cfg.DB.Host, "PostgreSQL host")
+	fs.IntVar(&cfg.DB.Port, "db-port", cfg.DB.Port, "PostgreSQL port")
+	fs.StringVar(&cfg.DB.Name, "db-name", cfg.DB.Name, "PostgreSQL database name")
+	fs.StringVar(&cfg.DB.User, "db-user", cfg.DB.User, "PostgreSQL user")
+	fs.Var(&cfg.DB.Password, "db-password", "PostgreSQL password")
+	fs.StringVar(&cfg.DB.SSLMode, "db-sslmode", cfg.DB.SSLMode, "PostgreSQL sslmode")
+	fs.IntVar(&cfg.DB.MaxOpenConns, "db-max-open-conns", cfg.DB.MaxOpenConns, "PostgreSQL max open connections")
+	fs.IntVar(&cfg.DB.MaxIdleConns, "db-max-idle-conns", cfg.DB.MaxIdleConns, "PostgreSQL max idle connections")
+	fs.DurationVar(&cfg.DB.MaxIdleTime, "db-max-idle-time", cfg.DB.MaxIdleTime, "PostgreSQL max connection idle time")
+
+	fs.StringVar(&cfg.JWT.KeysDir, "jwt-keys-dir", cfg.JWT.KeysDir, "Directory with JWT signing keys (*.pem)")
+	fs.StringVar(&cfg.JWT.ActiveKID, "jwt-active-kid", cfg.JWT.ActiveKID, "Key ID used to sign new tokens")
+	fs.StringVar(&cfg.JWT.Issuer, "jwt-issuer", cfg.JWT.Issuer, "JWT iss claim")
+	fs.StringVar(&cfg.JWT.Audience, "jwt-audience", cfg.JWT.Audience, "JWT aud claim")
+	fs.DurationVar(&cfg.JWT.ClockSkew, "jwt-clock-skew", cfg.JWT.ClockSkew, "Allowed clock skew when validating exp, nbf and iat")
+
+	fs.StringVar(&cfg.Tracing.Exporter, "tracing-exporter", cfg.Tracing.Exporter, "Where traces go (none|otlp|stdout)")
+	fs.StringVar(&cfg.Tracing.Endpoint, "tracing-endpoint", cfg.Tracing.Endpoint, "OTLP/HTTP collector URL")
+	fs.Float64Var(&cfg.Tracing.SampleRatio, "tracing-sample-ratio", cfg.Tracing.SampleRatio, "Share of new traces that are sampled, 0 to 1")
+	fs.StringVar(&cfg.Tracing.ServiceName, "tracing-service-name", cfg.Tracing.ServiceName, "service.name resource attribute")
+
+	fs.StringVar(&cfg.Log.Level, "log-level", cfg.Log.Level, "Minimum log level (debug|info|warn|error)")
+	fs.StringVar(&cfg.Log.Format, "log-format", cfg.Log.Format, "Log format (json|text), text in development by default")
+
+	fs.DurationVar(&cfg.PolicyRefresh, "policy-refresh", cfg.PolicyRefresh, "How often roles, permissions and catalogs are reread from the database, 0 to disable")
+	fs.BoolVar(&cfg.AutoMigrate, "auto-migrate", cfg.AutoMigrate, "Apply pending schema migrations on startup")
+	fs.BoolVar(&cfg.DummyLogin, "dummy-login", cfg.DummyLogin, "Serve POST /dummyLogin (development and testing only)")
+	fs.IntVar(&cfg.BatchMaxProducts, "batch-max-products", cfg.BatchMaxProducts, "Most products accepted by one batch request")
+	fs.DurationVar(&cfg.IdempotencyTTL, "idempotency-ttl", cfg.IdempotencyTTL, "How long responses are kept for replay under their Idempotency-Key")
+	fs.DurationVar(&cfg.EventsRetention, "events-retention", cfg.EventsRetention, "How long streamed events are kept for clients resuming with Last-Event-ID")
+	fs.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "How long to wait for in-flight requests and transactions on shutdown")
+}
+
+// Validate reports every invalid value at once.
+func (cfg Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(cfg.Port > 0 && cfg.Port < 65536, "port must be between 1 and 65535, got %d", cfg.Port)
+	check(cfg.GRPCPort >= 0 && cfg.GRPCPort < 65536, "grpc_port must be between 0 and 65535, got %d", cfg.GRPCPort)
+	check(cfg.GRPCPort != cfg.Port, "grpc_port must differ from port, both are %d", cfg.Port)
+	switch cfg.Env {
+	case "development", "testing", "staging", "production":
+	default:
+		errs = append(errs, fmt.Errorf("env must be development, testing, staging or production, got %q", cfg.Env))
+	}
+	check(!cfg.DummyLogin || cfg.Env == "development" || cfg.Env == "testing", "dummy_login is only allowed in development and testing, env is %q", cfg.Env)
+
+	check(cfg.DB.Host != "", "db host is required")
+	check(cfg.DB.Port > 0 && cfg.DB.Port < 65536, "db port must be between 1 and 65535, got %d", cfg.DB.Port)
+	check(cfg.DB.Name != "", "db name is required")
+	check(cfg.DB.User != "", "db user is required")
+	check(cfg.DB.MaxOpenConns >= 0, "db max_open_conns must not be negative, got %d", cfg.DB.MaxOpenConns)
+	check(cfg.DB.MaxIdleConns >= 0, "db max_idle_conns must not be negative, got %d", cfg.DB.MaxIdleConns)
+	check(cfg.DB.MaxOpenConns == 0 || cfg.DB.MaxIdleConns <= cfg.DB.MaxOpenConns,
+		"db max_idle_conns (%d) must not exceed max_open_conns (%d)", cfg.DB.MaxIdleConns, cfg.DB.MaxOpenConns)
+	check(cfg.DB.MaxIdleTime >= 0, "db max_idle_time must not be negative, got %s", cfg.DB.MaxIdleTime)
+
+	check(cfg.JWT.Issuer != "", "jwt issuer is required")
+	check(cfg.JWT.Audience != "", "jwt audience is required")
+	check(cfg.JWT.ClockSkew >= 0 && cfg.JWT.ClockSkew <= 5*time.Minute, "jwt clock_skew must be between 0 and 5m, got %s", cfg.JWT.ClockSkew)
+	check(cfg.JWT.ActiveKID == "" || cfg.JWT.KeysDir != "", "jwt active_kid needs keys_dir")
+
+	switch cfg.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if u, err := url.Parse(cfg.Tracing.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("tracing endpoint must be an http(s) URL, got %q", cfg.Tracing.Endpoint))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("tracing exporter must be none, otlp or stdout, got %q", cfg.Tracing.Exporter))
+	}
+	check(cfg.Tracing.SampleRatio >= 0 && cfg.Tracing.SampleRatio <= 1, "tracing sample_ratio must be between 0 and 1, got %g", cfg.Tracing.SampleRatio)
+	check(cfg.Tracing.ServiceName != "", "tracing service_name is required")
+
+	var level slog.Level
+	check(level.UnmarshalText([]byte(cfg.Log.Level)) == nil, "log level must be debug, info, warn or error, got %q", cfg.Log.Level)
+	check(cfg.Log.Format == "" || cfg.Log.Format == "json" || cfg.Log.Format == "text", "log format must be json or text, got %q", cfg.Log.Format)
+
+	check(cfg.PolicyRefresh >= 0, "policy_refresh must not be negative, got %s", cfg.PolicyRefresh)
+	check(cfg.BatchMaxProducts >= 1 && cfg.BatchMaxProducts <= 10000, "batch_max_products must be between 1 and 10000, got %d", cfg.BatchMaxProducts)
+	check(cfg.IdempotencyTTL >= time.Minute, "idempotency_ttl must be at least 1m, got %s", cfg.IdempotencyTTL)
+	check(cfg.EventsRetention >= time.Minute, "events_retention must be at least 1m, got %s", cfg.EventsRetention)
+	check(cfg.ShutdownTimeout > 0 && cfg.ShutdownTimeout <= 10*time.Minute, "shutdown_timeout must be between 0 and 10m, got %s", cfg.ShutdownTimeout)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// The MarshalJSON methods print durations as "1m0s" rather than nanoseconds.
+
+func (cfg Config) MarshalJSON() ([]byte, error) {
+	type plain Config
+	return json.Marshal(struct {
+		plain
+		PolicyRefresh   string `json:"policyRefresh"`
+		IdempotencyTTL  string `json:"idempotencyTtl"`
+		EventsRetention string `json:"eventsRetention"`
+		ShutdownTimeout string `json:"shutdownTimeout"`
+	}{plain(cfg), cfg.PolicyRefresh.String(), cfg.IdempotencyTTL.String(), cfg.EventsRetention.String(), cfg.ShutdownTimeout.String()})
+}
+
+func (db DB) MarshalJSON() ([]byte, error) {
+	type plain DB
+	return json.Marshal(struct {
+		plain
+		MaxIdleTime string `json:"maxIdleTime"`
+	}{plain(db), db.MaxIdleTime.String()})
+}
+
+func (jwt JWT) MarshalJSON() ([]byte, error) {
+	type plain JWT
+	return json.Marshal(struct {
+		plain
+		ClockSkew string `json:"clockSkew"`
+	}{plain(jwt), jwt.ClockSkew.String()})
+}
+
+// DSN is the lib/pq connection URL.
+func (db DB) DSN() string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(db.User, string(db.Password)),
+		Host:     fmt.Sprintf("%s:%d", db.Host, db.Port),
+		Path:     db.Name,
+		RawQuery: url.Values{"sslmode": {db.SSLMode}}.Encode(),
+	}
+	return u.String()
+}
+
+// String prints the effective configuration with secrets redacted.
+func (cfg Config) String() string {
+	out, err := json.Marshal(cfg)
+	if err != nil {
+		return err.Error()
+	}
+	return string(out)
+}
@@ -0,0 +1,245 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// envKeys are the variables Load reads; clearEnv blanks them, which Load
+// treats as unset, so the tests do not see the environment they run in.
+var envKeys = []string{
+	"CONFIG_FILE", "PORT", "GRPC_PORT", "ENV",
+	"DATABASE_HOST", "DATABASE_PORT", "DATABASE_NAME", "DATABASE_USER", "DATABASE_PASSWORD", "DATABASE_SSLMODE",
+	"DATABASE_MAX_OPEN_CONNS", "DATABASE_MAX_IDLE_CONNS", "DATABASE_MAX_IDLE_TIME",
+	"JWT_KEYS_DIR", "JWT_ACTIVE_KID", "JWT_ISSUER", "JWT_AUDIENCE", "JWT_CLOCK_SKEW",
+	"TRACING_EXPORTER", "TRACING_ENDPOINT", "TRACING_SAMPLE_RATIO", "TRACING_SERVICE_NAME",
+	"LOG_LEVEL", "LOG_FORMAT", "POLICY_REFRESH_INTERVAL", "AUTO_MIGRATE", "DUMMY_LOGIN",
+	"IDEMPOTENCY_TTL", "EVENTS_RETENTION", "BATCH_MAX_PRODUCTS", "SHUTDOWN_TIMEOUT",
+}
+
+func clearEnv(t *testing.T) {
+	for _, key := range envKeys {
+		t.Setenv(key, "")
+	}
+}
+
+func writeConfig(t *testing.T, body string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func load(t *testing.T, args ...string) (Config, error) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(new(bytes.Buffer))
+	return Load(fs, args)
+}
+
+func TestPrecedence(t *testing.T) {
+	file := "port: 8081\ndb:\n  name: from_file\n"
+
+	for _, tc := range []struct {
+		name     string
+		file     bool
+		env      string
+		args     []string
+		wantPort int
+	}{
+		{name: "default", wantPort: 8080},
+		{name: "yaml over default", file: true, wantPort: 8081},
+		{name: "env over default", env: "8082", wantPort: 8082},
+		{name: "env over yaml", file: true, env: "8082", wantPort: 8082},
+		{name: "flag over yaml", file: true, args: []string{"-port", "8083"}, wantPort: 8083},
+		{name: "flag over env", file: true, env: "8082", args: []string{"-port=8083"}, wantPort: 8083},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			clearEnv(t)
+			if tc.file {
+				t.Setenv("CONFIG_FILE", writeConfig(t, file))
+			}
+			if tc.env != "" {
+				t.Setenv("PORT", tc.env)
+			}
+			cfg, err := load(t, tc.args...)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tc.wantPort, cfg.Port)
+
+			// a layer only overrides what it sets
+			if tc.file {
+				assert.Equal(t, "from_file", cfg.DB.Name)
+			} else {
+				assert.Equal(t, Default().DB.Name, cfg.DB.Name)
+			}
+			assert.Equal(t, Default().DB.Host, cfg.DB.Host)
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{name: "empty file", body: ""},
+		{name: "known keys", body: "env: testing\ntracing:\n  exporter: stdout\n"},
+		{name: "unknown key", body: "prot: 8081\n", wantErr: "field prot not found"},
+		{name: "unknown nested key", body: "db:\n  hots: db\n", wantErr: "field hots not found"},
+		{name: "wrong type", body: "port: eighty\n", wantErr: "failed to parse config file"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			clearEnv(t)
+			_, err := load(t, "-config", writeConfig(t, tc.body))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+
+	clearEnv(t)
+	_, err := load(t, "-config", filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read config file")
+}
+
+func TestConfigFileArg(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "equals", args: []string{"-config=x.yaml"}, want: "x.yaml"},
+		{name: "separate value", args: []string{"-config", "x.yaml"}, want: "x.yaml"},
+		{name: "double dash", args: []string{"--config", "x.yaml"}, want: "x.yaml"},
+		{name: "after other flags", args: []string{"-port", "9000", "-auto-migrate", "-config", "x.yaml"}, want: "x.yaml"},
+		{name: "after a command flag", args: []string{"-force", "-steps", "2", "-config=x.yaml"}, want: "x.yaml"},
+		{name: "value of another flag", args: []string{"-db-name", "-config"}, want: ""},
+		{name: "after the flags end", args: []string{"up", "-config", "x.yaml"}, want: ""},
+		{name: "absent", args: []string{"-port", "9000"}, want: ""},
+		{name: "after a bad flag", args: []string{"-nope", "-config", "x.yaml"}, want: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.Bool("force", false, "")
+			fs.Int("steps", 1, "")
+			assert.Equal(t, tc.want, configFileArg(fs, tc.args))
+		})
+	}
+
+	// both forms reach the file
+	for _, args := range [][]string{{"-config=%s"}, {"-config", "%s"}} {
+		clearEnv(t)
+		path := writeConfig(t, "port: 8081\n")
+		args[len(args)-1] = fmt.Sprintf(args[len(args)-1], path)
+		cfg, err := load(t, args...)
+		if assert.NoError(t, err, args) {
+			assert.Equal(t, 8081, cfg.Port, args)
+			assert.Equal(t, path, cfg.File, args)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Default().Validate())
+
+	for _, tc := range []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{"port", func(c *Config) { c.Port = 0 }, "port must be between 1 and 65535"},
+		{"grpc port", func(c *Config) { c.GRPCPort = 70000 }, "grpc_port must be between 0 and 65535"},
+		{"same ports", func(c *Config) { c.GRPCPort = c.Port }, "grpc_port must differ from port"},
+		{"env", func(c *Config) { c.Env = "prod" }, "env must be development, testing, staging or production"},
+		{"dummy login", func(c *Config) { c.Env, c.DummyLogin = "production", true }, "dummy_login is only allowed"},
+		{"db host", func(c *Config) { c.DB.Host = "" }, "db host is required"},
+		{"db port", func(c *Config) { c.DB.Port = -1 }, "db port must be between 1 and 65535"},
+		{"db name", func(c *Config) { c.DB.Name = "" }, "db name is required"},
+		{"db user", func(c *Config) { c.DB.User = "" }, "db user is required"},
+		{"max open conns", func(c *Config) { c.DB.MaxOpenConns = -1 }, "db max_open_conns must not be negative"},
+		{"max idle conns", func(c *Config) { c.DB.MaxIdleConns = -1 }, "db max_idle_conns must not be negative"},
+		{"idle over open", func(c *Config) { c.DB.MaxOpenConns, c.DB.MaxIdleConns = 5, 10 }, "must not exceed max_open_conns"},
+		{"max idle time", func(c *Config) { c.DB.MaxIdleTime = -time.Second }, "db max_idle_time must not be negative"},
+		{"jwt issuer", func(c *Config) { c.JWT.Issuer = "" }, "jwt issuer is required"},
+		{"jwt audience", func(c *Config) { c.JWT.Audience = "" }, "jwt audience is required"},
+		{"clock skew", func(c *Config) { c.JWT.ClockSkew = time.Hour }, "jwt clock_skew must be between 0 and 5m"},
+		{"active kid", func(c *Config) { c.JWT.ActiveKID = "k1" }, "jwt active_kid needs keys_dir"},
+		{"otlp endpoint", func(c *Config) { c.Tracing.Exporter, c.Tracing.Endpoint = "otlp", "localhost:4318" }, "tracing endpoint must be an http(s) URL"},
+		{"exporter", func(c *Config) { c.Tracing.Exporter = "jaeger" }, "tracing exporter must be none, otlp or stdout"},
+		{"sample ratio", func(c *Config) { c.Tracing.SampleRatio = 1.5 }, "tracing sample_ratio must be between 0 and 1"},
+		{"service name", func(c *Config) { c.Tracing.ServiceName = "" }, "tracing service_name is required"},
+		{"log level", func(c *Config) { c.Log.Level = "verbose" }, "log level must be debug, info, warn or error"},
+		{"log format", func(c *Config) { c.Log.Format = "xml" }, "log format must be json or text"},
+		{"policy refresh", func(c *Config) { c.PolicyRefresh = -time.Second }, "policy_refresh must not be negative"},
+		{"batch size", func(c *Config) { c.BatchMaxProducts = 0 }, "batch_max_products must be between 1 and 10000"},
+		{"idempotency ttl", func(c *Config) { c.IdempotencyTTL = time.Second }, "idempotency_ttl must be at least 1m"},
+		{"events retention", func(c *Config) { c.EventsRetention = time.Second }, "events_retention must be at least 1m"},
+		{"shutdown timeout", func(c *Config) { c.ShutdownTimeout = 0 }, "shutdown_timeout must be between 0 and 10m"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := Default()
+			tc.modify(&cfg)
+			err := cfg.Validate()
+			assert.ErrorContains(t, err, tc.wantErr)
+			assert.ErrorContains(t, err, "invalid config: ")
+		})
+	}
+
+	// every problem is reported, not just the first
+	cfg := Default()
+	cfg.Port, cfg.DB.Host = 0, ""
+	err := cfg.Validate()
+	assert.ErrorContains(t, err, "port must be")
+	assert.ErrorContains(t, err, "db host is required")
+}
+
+func TestSecretRedacted(t *testing.T) {
+	cfg := Default()
+	cfg.DB.Password = "hunter2"
+
+	out, err := json.Marshal(cfg)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(out), "hunter2")
+
+	var decoded struct {
+		DB struct {
+			Password string `json:"password"`
+		} `json:"db"`
+		PolicyRefresh string `json:"policyRefresh"`
+	}
+	assert.NoError(t, json.Unmarshal(out, &decoded))
+	assert.Equal(t, redacted, decoded.DB.Password)
+	assert.Equal(t, "1m0s", decoded.PolicyRefresh)
+
+	assert.NotContains(t, cfg.String(), "hunter2")
+	assert.Equal(t, redacted, fmt.Sprint(cfg.DB.Password))
+	assert.Equal(t, "", Secret("").String())
+	assert.Contains(t, cfg.DB.DSN(), "hunter2")
+
+	// nor in the flag help
+	clearEnv(t)
+	t.Setenv("DATABASE_PASSWORD", "hunter2")
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var help bytes.Buffer
+	fs.SetOutput(&help)
+	loaded, err := Load(fs, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, Secret("hunter2"), loaded.DB.Password)
+	fs.PrintDefaults()
+	assert.Contains(t, help.String(), "-db-password")
+	assert.False(t, strings.Contains(help.String(), "hunter2"))
+}
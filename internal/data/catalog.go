@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"sync"
+
+	"github.com/wisp167/pvz/internal/db"
+)
+
+// Catalog caches the active cities and product types, so validating a request
+// does not cost a query.
+type Catalog struct {
+	mu           sync.RWMutex
+	cities       map[string]bool
+	productTypes map[string]bool
+}
+
+func NewCatalog() *Catalog {
+	return &Catalog{cities: make(map[string]bool), productTypes: make(map[string]bool)}
+}
+
+func (c *Catalog) HasCity(name string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cities[name]
+}
+
+func (c *Catalog) HasProductType(name string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.productTypes[name]
+}
+
+// LoadCatalog rereads the reference tables into m.Catalog.
+func (m *Models) LoadCatalog(reqCtx context.Context) error {
+
+	var cities []db.ListCitiesRow
+	var productTypes []db.ListProductTypesRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		cities, err = q.ListCities(ctx)
+		if err != nil {
+			return err
+		}
+		productTypes, err = q.ListProductTypes(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	cityNames := make(map[string]bool, len(cities))
+	for _, city := range cities {
+		if city.Active {
+			cityNames[city.Name] = true
+		}
+	}
+	typeNames := make(map[string]bool, len(productTypes))
+	for _, productType := range productTypes {
+		if productType.Active {
+			typeNames[productType.Name] = true
+		}
+	}
+
+	m.Catalog.mu.Lock()
+	m.Catalog.cities, m.Catalog.productTypes = cityNames, typeNames
+	m.Catalog.mu.Unlock()
+	return nil
+}
+
+func (m *Models) ListCities(reqCtx context.Context) ([]db.ListCitiesRow, error) {
+
+	var cities []db.ListCitiesRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		cities, err = q.ListCities(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cities, nil
+}
+
+// SaveCity adds a city or switches it on or off. A deactivated city keeps its
+// PVZs but no new PVZ can be opened there.
+func (m *Models) SaveCity(reqCtx context.Context, name string, active bool) (db.UpsertCityRow, error) {
+
+	var city db.UpsertCityRow
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		city, err = q.UpsertCity(ctx, db.UpsertCityParams{Name: name, Active: active})
+		return err
+	})
+	if err != nil {
+		return db.UpsertCityRow{}, err
+	}
+	return city, m.LoadCatalog(reqCtx)
+}
+
+func (m *Models) ListProductTypes(reqCtx context.Context) ([]db.ListProductTypesRow, error) {
+
+	var productTypes []db.ListProductTypesRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		productTypes, err = q.ListProductTypes(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return productTypes, nil
+}
+
+func (m *Models) SaveProductType(reqCtx context.Context, name string, active bool) (db.UpsertProductTypeRow, error) {
+
+	var productType db.UpsertProductTypeRow
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		productType, err = q.UpsertProductType(ctx, db.UpsertProductTypeParams{Name: name, Active: active})
+		return err
+	})
+	if err != nil {
+		return db.UpsertProductTypeRow{}, err
+	}
+	return productType, m.LoadCatalog(reqCtx)
+}
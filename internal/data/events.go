@@ -0,0 +1,49 @@
+package data
+
+import (
+	"context"
+
+	"github.com/wisp167/pvz/internal/db"
+)
+
+// Events is told about business events after the transaction that made them
+// commits, so a rolled back scan is never counted. Implementations must be
+// safe for concurrent use and must not block.
+type Events interface {
+	ReceptionOpened(city string)
+	ReceptionClosed(city string)
+	ReceptionCancelled(city string)
+	ReceptionVerified(city string)
+	ProductAdded(productType, city string)
+	ProductDeleted(productType, city string)
+}
+
+type noEvents struct{}
+
+func (noEvents) ReceptionOpened(string)        {}
+func (noEvents) ReceptionClosed(string)        {}
+func (noEvents) ReceptionCancelled(string)     {}
+func (noEvents) ReceptionVerified(string)      {}
+func (noEvents) ProductAdded(string, string)   {}
+func (noEvents) ProductDeleted(string, string) {}
+
+// OpenReceptionsByCity counts the receptions in progress per city.
+func (m *Models) OpenReceptionsByCity(reqCtx context.Context) (map[string]int64, error) {
+
+	var rows []db.CountOpenReceptionsByCityRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		rows, err = q.CountOpenReceptionsByCity(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.City] = row.OpenReceptions
+	}
+	return counts, nil
+}
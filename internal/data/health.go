@@ -0,0 +1,24 @@
+package data
+
+import (
+	"context"
+	"fmt"
+)
+
+// Health runs the readiness checks: the pool reaches the database, the
+// prepared statements still work and no migration is pending. A nil error
+// means the check passed.
+func (m *Models) Health(ctx context.Context) map[string]error {
+	checks := make(map[string]error, 3)
+
+	checks["database"] = m.PVZ.DB.PingContext(ctx)
+	_, checks["statements"] = m.PVZ.Queries.Ping(ctx)
+
+	pending, err := m.migrations.Pending(ctx)
+	if err == nil && len(pending) > 0 {
+		err = fmt.Errorf("%d migrations pending, first %04d_%s", len(pending), pending[0].Version, pending[0].Name)
+	}
+	checks["migrations"] = err
+
+	return checks
+}
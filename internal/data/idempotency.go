@@ -0,0 +1,103 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wisp167/pvz/internal/db"
+)
+
+var (
+	ErrIdempotencyKeyReused     = errors.New("idempotency key reused with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("request with this idempotency key is still in progress")
+)
+
+// IdempotencyLockTimeout is how long a claimed key waits for its response.
+// After that the instance that claimed it is presumed dead and a retry may
+// run the request again.
+const IdempotencyLockTimeout = time.Minute
+
+// IdempotentResponse is the stored result of a request sent with an
+// Idempotency-Key.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// BeginIdempotentRequest claims key for userID. It returns a nil response
+// when the request should run, after which the caller must either
+// CompleteIdempotentRequest or ReleaseIdempotentRequest. If the key already
+// holds a response to the same request, that response is returned instead.
+func (m *Models) BeginIdempotentRequest(reqCtx context.Context, userID uuid.UUID, key string, requestHash []byte, ttl time.Duration) (*IdempotentResponse, error) {
+
+	var stored *IdempotentResponse
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		if err := q.DeleteExpiredIdempotencyKeys(ctx, userID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		_, err := q.ClaimIdempotencyKey(ctx, db.ClaimIdempotencyKeyParams{
+			UserID:      userID,
+			Key:         key,
+			RequestHash: requestHash,
+			ExpiresAt:   now.Add(ttl),
+			StaleBefore: now.Add(-IdempotencyLockTimeout),
+		})
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		row, err := q.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{UserID: userID, Key: key})
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(row.RequestHash, requestHash) {
+			return ErrIdempotencyKeyReused
+		}
+		if !row.StatusCode.Valid {
+			return ErrIdempotencyKeyInProgress
+		}
+		stored = &IdempotentResponse{
+			StatusCode:  int(row.StatusCode.Int32),
+			ContentType: row.ContentType.String,
+			Body:        row.ResponseBody,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+// CompleteIdempotentRequest stores the response to replay for key.
+func (m *Models) CompleteIdempotentRequest(reqCtx context.Context, userID uuid.UUID, key string, resp IdempotentResponse) error {
+
+	return m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		return q.CompleteIdempotencyKey(ctx, db.CompleteIdempotencyKeyParams{
+			UserID:       userID,
+			Key:          key,
+			StatusCode:   sql.NullInt32{Int32: int32(resp.StatusCode), Valid: true},
+			ContentType:  sql.NullString{String: resp.ContentType, Valid: resp.ContentType != ""},
+			ResponseBody: resp.Body,
+		})
+	})
+}
+
+// ReleaseIdempotentRequest gives up a claimed key without a response, so the
+// request can be retried with it.
+func (m *Models) ReleaseIdempotentRequest(reqCtx context.Context, userID uuid.UUID, key string) error {
+
+	return m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		return q.ReleaseIdempotencyKey(ctx, db.ReleaseIdempotencyKeyParams{UserID: userID, Key: key})
+	})
+}
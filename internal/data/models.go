@@ -4,17 +4,43 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"sync"
 
 	"github.com/wisp167/pvz/internal/db"
+	"github.com/wisp167/pvz/internal/helpers"
+	"github.com/wisp167/pvz/internal/migrate"
+	"github.com/wisp167/pvz/internal/policy"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/wisp167/pvz/internal/data")
+
 var (
-	ErrRecordNotFound = errors.New("record not found")
+	ErrRecordNotFound     = errors.New("record not found")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrDuplicateBarcode   = errors.New("barcode already scanned in this reception")
+	ErrUnknownCity        = errors.New("unknown or inactive city")
+	ErrUnknownProductType = errors.New("unknown or inactive product type")
+	ErrShuttingDown       = errors.New("database is shutting down")
 )
 
 type Models struct {
-	PVZv1 PVZModelv1
-	PVZ   PVZModel
+	PVZv1  PVZModelv1
+	PVZ    PVZModel
+	Hasher helpers.PasswordHasher
+	// Policy caches the role table; refresh it with LoadPolicy
+	Policy *policy.Engine
+	// Catalog caches the cities and product types; refresh it with LoadCatalog
+	Catalog *Catalog
+	// Events hears about receptions and products once their transaction commits
+	Events Events
+
+	txs        *txTracker
+	migrations *migrate.Migrator
 }
 
 func NewModels(db_ *sql.DB) (Models, error) {
@@ -24,14 +50,99 @@ func NewModels(db_ *sql.DB) (Models, error) {
 	if err != nil {
 		return Models{}, err
 	}
+	migrations, err := migrate.New(db_)
+	if err != nil {
+		return Models{}, err
+	}
 
 	return Models{
-		PVZ: PVZModel{DB: db_, Queries: queries},
+		PVZ:        PVZModel{DB: db_, Queries: queries},
+		Hasher:     helpers.NewArgon2idHasher(),
+		Policy:     policy.NewEngine(),
+		Catalog:    NewCatalog(),
+		Events:     noEvents{},
+		txs:        &txTracker{},
+		migrations: migrations,
 	}, nil
 }
 
-// Transaction executes a function within a database transaction
-func (m *Models) Transaction(ctx context.Context, fn func(*db.Queries) error) error {
+// Close refuses new transactions, waits until the running ones finish or ctx
+// is done, then closes the prepared statements and the connection pool.
+func (m *Models) Close(ctx context.Context) error {
+	var errs []error
+	select {
+	case <-m.txs.close():
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("%d transactions still running: %w", m.txs.running(), ctx.Err()))
+	}
+	if err := m.PVZ.Queries.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	// sql.DB.Close still waits for queries already sent to the server
+	if err := m.PVZ.DB.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// txTracker counts running transactions so Close can wait for them.
+type txTracker struct {
+	mu      sync.Mutex
+	active  int
+	closing bool
+	idle    chan struct{}
+}
+
+func (t *txTracker) begin() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closing {
+		return false
+	}
+	t.active++
+	return true
+}
+
+func (t *txTracker) end() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.active--
+	if t.closing && t.active == 0 {
+		close(t.idle)
+	}
+}
+
+// close returns a channel that is closed once no transaction is running.
+func (t *txTracker) close() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.closing {
+		t.closing = true
+		t.idle = make(chan struct{})
+		if t.active == 0 {
+			close(t.idle)
+		}
+	}
+	return t.idle
+}
+
+func (t *txTracker) running() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.active
+}
+
+// Transaction executes a function within a database transaction. fn gets
+// the transaction's context, so the queries it runs are traced under it.
+func (m *Models) Transaction(ctx context.Context, fn func(context.Context, *db.Queries) error) (err error) {
+	if !m.txs.begin() {
+		return ErrShuttingDown
+	}
+	defer m.txs.end()
+
+	ctx, span := tracer.Start(ctx, "Models.Transaction")
+	defer func() { endSpan(span, err) }()
+
 	tx, err := m.PVZ.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -41,7 +152,7 @@ func (m *Models) Transaction(ctx context.Context, fn func(*db.Queries) error) er
 	txQueries := m.PVZ.Queries.WithTx(tx)
 
 	// Execute the callback
-	if err := fn(txQueries); err != nil {
+	if err := fn(ctx, txQueries); err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -50,7 +161,16 @@ func (m *Models) Transaction(ctx context.Context, fn func(*db.Queries) error) er
 }
 
 // ReadOnlyTransaction executes a function within a read-only transaction
-func (m *Models) ReadOnlyTransaction(ctx context.Context, fn func(*db.Queries) error) error {
+func (m *Models) ReadOnlyTransaction(ctx context.Context, fn func(context.Context, *db.Queries) error) (err error) {
+	if !m.txs.begin() {
+		return ErrShuttingDown
+	}
+	defer m.txs.end()
+
+	ctx, span := tracer.Start(ctx, "Models.ReadOnlyTransaction",
+		trace.WithAttributes(attribute.Bool("db.transaction.read_only", true)))
+	defer func() { endSpan(span, err) }()
+
 	tx, err := m.PVZ.DB.BeginTx(ctx, &sql.TxOptions{
 		ReadOnly: true,
 	})
@@ -59,7 +179,7 @@ func (m *Models) ReadOnlyTransaction(ctx context.Context, fn func(*db.Queries) e
 	}
 
 	txQueries := m.PVZ.Queries.WithTx(tx)
-	if err := fn(txQueries); err != nil {
+	if err := fn(ctx, txQueries); err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -67,3 +187,12 @@ func (m *Models) ReadOnlyTransaction(ctx context.Context, fn func(*db.Queries) e
 	// Always rollback read-only transactions
 	return tx.Rollback()
 }
+
+// endSpan records err on span, if any, and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/db"
+)
+
+var ErrReceptionNotInProgress = errors.New("reception is not in progress")
+
+// ProductBatchResult is the outcome of one item passed to AddProducts: the
+// inserted product, or Err saying why the item was skipped.
+type ProductBatchResult struct {
+	Product db.AddProductsRow
+	Err     error
+}
+
+// AddProducts inserts items into receptionID with a single statement in one
+// transaction, keeping their order. Items with an unknown type or a barcode
+// already in the reception, or earlier in the batch, are skipped with
+// ErrUnknownProductType or ErrDuplicateBarcode in their result; the rest are
+// inserted. The reception must be in progress, else ErrReceptionNotInProgress.
+func (m *Models) AddProducts(reqCtx context.Context, receptionID uuid.UUID, items []api.ProductBatchItem, userID uuid.UUID) ([]ProductBatchResult, error) {
+
+	results := make([]ProductBatchResult, len(items))
+	var city string
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		reception, err := q.LockReceptionInProgress(ctx, receptionID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrReceptionNotInProgress
+		}
+		if err != nil {
+			return err
+		}
+		city = reception.City
+
+		var barcodes []string
+		for _, item := range items {
+			if item.Barcode != nil {
+				barcodes = append(barcodes, *item.Barcode)
+			}
+		}
+		taken := make(map[string]bool)
+		if len(barcodes) > 0 {
+			live, err := q.ListLiveBarcodes(ctx, db.ListLiveBarcodesParams{ReceptionID: receptionID, Barcodes: barcodes})
+			if err != nil {
+				return err
+			}
+			for _, barcode := range live {
+				taken[barcode] = true
+			}
+		}
+
+		params := db.AddProductsParams{ReceptionID: receptionID, CreatedBy: userID}
+		var accepted []int
+		for i, item := range items {
+			if !m.Catalog.HasProductType(item.Type) {
+				results[i].Err = ErrUnknownProductType
+				continue
+			}
+			if item.Barcode != nil {
+				if taken[*item.Barcode] {
+					results[i].Err = ErrDuplicateBarcode
+					continue
+				}
+				taken[*item.Barcode] = true
+			}
+			accepted = append(accepted, i)
+
+			params.Types = append(params.Types, item.Type)
+			params.Barcodes = append(params.Barcodes, stringOrEmpty(item.Barcode))
+			params.Skus = append(params.Skus, stringOrEmpty(item.Sku))
+			params.WeightsGrams = append(params.WeightsGrams, intOrZero(item.WeightGrams))
+			var d api.Dimensions
+			if item.Dimensions != nil {
+				d = *item.Dimensions
+			}
+			params.LengthsMm = append(params.LengthsMm, int32(d.LengthMm))
+			params.WidthsMm = append(params.WidthsMm, int32(d.WidthMm))
+			params.HeightsMm = append(params.HeightsMm, int32(d.HeightMm))
+		}
+		if len(accepted) == 0 {
+			return nil
+		}
+
+		rows, err := q.AddProducts(ctx, params)
+		if err != nil {
+			return err
+		}
+		if len(rows) != len(accepted) {
+			return fmt.Errorf("batch inserted %d of %d products", len(rows), len(accepted))
+		}
+		// RETURNING order is not guaranteed, the sequence is
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Sequence < rows[j].Sequence })
+		productIDs := make([]uuid.UUID, len(rows))
+		for j, row := range rows {
+			results[accepted[j]].Product = row
+			productIDs[j] = row.ID
+		}
+		return publishProductEvents(ctx, q, db.PublishProductEventsParams{
+			Type:       EventProductAdded,
+			PvzID:      reception.PvzID,
+			City:       city,
+			ActorID:    userID,
+			ProductIds: productIDs,
+		})
+	})
+	// a barcode added by a concurrent request after ListLiveBarcodes
+	if isUniqueViolation(err) {
+		return nil, ErrDuplicateBarcode
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.Err == nil {
+			m.Events.ProductAdded(result.Product.Type, city)
+		}
+	}
+	return results, nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func intOrZero(n *int) int32 {
+	if n == nil {
+		return 0
+	}
+	return int32(*n)
+}
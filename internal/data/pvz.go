@@ -0,0 +1,145 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/db"
+)
+
+var ErrPVZHasOpenReception = errors.New("pvz has a reception in progress")
+
+// GetPVZByID returns a PVZ with all of its receptions, including deactivated PVZs.
+func (m *Models) GetPVZByID(reqCtx context.Context, pvzID uuid.UUID, includeDeleted bool) (PVZWithReceptionsResponse, error) {
+
+	var row db.GetPVZWithReceptionsRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		row, err = q.GetPVZWithReceptions(ctx, db.GetPVZWithReceptionsParams{ID: pvzID, Column2: includeDeleted})
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return PVZWithReceptionsResponse{}, ErrRecordNotFound
+	}
+	if err != nil {
+		return PVZWithReceptionsResponse{}, err
+	}
+
+	var receptions []ReceptionWithProducts
+	if err := json.Unmarshal([]byte(row.ReceptionsJson), &receptions); err != nil {
+		return PVZWithReceptionsResponse{}, fmt.Errorf("failed to unmarshal receptions JSON: %w", err)
+	}
+
+	id := openapi_types.UUID(row.PvzID)
+	resp := PVZWithReceptionsResponse{
+		PVZ: api.PVZ{
+			Id:   &id,
+			City: row.City,
+		},
+		Receptions: receptions,
+	}
+	if row.RegistrationDate.Valid {
+		resp.PVZ.RegistrationDate = &row.RegistrationDate.Time
+	}
+	if row.DeactivatedAt.Valid {
+		resp.PVZ.DeactivatedAt = &row.DeactivatedAt.Time
+	}
+	return resp, nil
+}
+
+// UpdatePVZ corrects the city of an active PVZ.
+func (m *Models) UpdatePVZ(reqCtx context.Context, pvzID uuid.UUID, city string) (db.UpdatePVZRow, error) {
+	if !m.Catalog.HasCity(city) {
+		return db.UpdatePVZRow{}, ErrUnknownCity
+	}
+
+	var pvz db.UpdatePVZRow
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		pvz, err = q.UpdatePVZ(ctx, db.UpdatePVZParams{ID: pvzID, City: city})
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.UpdatePVZRow{}, ErrRecordNotFound
+	}
+	if err != nil {
+		return db.UpdatePVZRow{}, err
+	}
+	return pvz, nil
+}
+
+// DeactivatePVZ hides a PVZ from listings and stops new receptions there.
+// The PVZ row is locked first, so a reception cannot be opened between the
+// check and the update.
+func (m *Models) DeactivatePVZ(reqCtx context.Context, pvzID, userID uuid.UUID) error {
+
+	return m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		deactivatedAt, err := q.LockPVZ(ctx, pvzID)
+		if errors.Is(err, sql.ErrNoRows) || deactivatedAt.Valid {
+			return ErrRecordNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		open, err := q.HasOpenReceptions(ctx, pvzID)
+		if err != nil {
+			return err
+		}
+		if open {
+			return ErrPVZHasOpenReception
+		}
+
+		_, err = q.DeactivatePVZ(ctx, db.DeactivatePVZParams{
+			ID:            pvzID,
+			DeactivatedBy: uuid.NullUUID{UUID: userID, Valid: true},
+		})
+		return err
+	})
+}
+
+// ImportPVZs adds PVZs moved from another system in one transaction, keeping
+// their id and registration date when given. PVZs whose id already exists
+// are skipped, so an import can be rerun; the result holds only the new ones.
+func (m *Models) ImportPVZs(reqCtx context.Context, pvzs []api.PVZ) ([]db.ImportPVZRow, error) {
+	for i, pvz := range pvzs {
+		if !m.Catalog.HasCity(pvz.City) {
+			return nil, fmt.Errorf("pvz %d (%s): %w", i+1, pvz.City, ErrUnknownCity)
+		}
+	}
+
+	var imported []db.ImportPVZRow
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		for _, pvz := range pvzs {
+			params := db.ImportPVZParams{City: pvz.City}
+			if pvz.Id != nil {
+				params.ID = uuid.NullUUID{UUID: *pvz.Id, Valid: true}
+			}
+			if pvz.RegistrationDate != nil {
+				params.RegistrationDate = sql.NullTime{Time: *pvz.RegistrationDate, Valid: true}
+			}
+			row, err := q.ImportPVZ(ctx, params)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			imported = append(imported, row)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imported, nil
+}
@@ -14,6 +14,9 @@ import (
 	"github.com/wisp167/pvz/internal/helpers"
 )
 
+// unusablePasswordHash never matches any password
+const unusablePasswordHash = "!"
+
 type PVZModelv1 struct {
 	DB *sql.DB
 }
@@ -23,38 +26,67 @@ type PVZModel struct {
 	Queries *db.Queries
 }
 
-func (m *Models) Login(reqCtx context.Context, req api.PostLoginJSONBody) (string, error) {
+func (m *Models) Login(reqCtx context.Context, req api.PostLoginJSONBody) (db.GetUserByIDRow, error) {
 
-	var res string
+	var user db.GetUserByEmailRow
 
-	err := m.Transaction(reqCtx, func(q *db.Queries) error {
-		user := db.GetUserByCredentialsParams{Email: string(req.Email), Md5: helpers.Md5(req.Password)}
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
 		var err error
-		res, err = q.GetUserByCredentials(reqCtx, user)
-		if err != nil {
-			return err
-		}
-		return nil
+		user, err = q.GetUserByEmail(ctx, string(req.Email))
+		return err
 	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.GetUserByIDRow{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return db.GetUserByIDRow{}, err
+	}
 
+	ok, needsRehash, err := m.Hasher.Verify(req.Password, user.PasswordHash)
+	if errors.Is(err, helpers.ErrUnknownHashFormat) {
+		return db.GetUserByIDRow{}, ErrInvalidCredentials
+	}
 	if err != nil {
-		return "", err
+		return db.GetUserByIDRow{}, err
+	}
+	if !ok {
+		return db.GetUserByIDRow{}, ErrInvalidCredentials
 	}
-	return api.Token(res), nil
+
+	// Upgrade legacy md5 rows (and hashes with outdated parameters) in place
+	if needsRehash {
+		hash, err := m.Hasher.Hash(req.Password)
+		if err != nil {
+			return db.GetUserByIDRow{}, err
+		}
+		err = m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+			return q.UpdateUserPasswordHash(ctx, db.UpdateUserPasswordHashParams{ID: user.ID, PasswordHash: hash})
+		})
+		if err != nil {
+			return db.GetUserByIDRow{}, err
+		}
+	}
+
+	return db.GetUserByIDRow{ID: user.ID, Email: user.Email, Role: user.Role, City: user.City}, nil
 }
 
 func (m *Models) Register(reqCtx context.Context, req api.PostRegisterJSONBody) (db.CreateUserRow, error) {
 
 	var resp db.CreateUserRow
 
-	err := m.Transaction(reqCtx, func(q *db.Queries) error {
-		user := db.CreateUserParams{Email: string(req.Email), Md5: helpers.Md5(req.Password), Role: string(req.Role)}
+	hash, err := m.Hasher.Hash(req.Password)
+	if err != nil {
+		return db.CreateUserRow{}, err
+	}
+
+	err = m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		user := db.CreateUserParams{Email: string(req.Email), PasswordHash: hash, Role: string(req.Role)}
 		var err error
-		_, err = q.GetUserByCredentials(reqCtx, db.GetUserByCredentialsParams{Email: string(req.Email), Md5: helpers.Md5(req.Password)})
+		_, err = q.GetUserByEmail(ctx, string(req.Email))
 		if !errors.Is(err, sql.ErrNoRows) {
 			return sql.ErrNoRows
 		}
-		resp, err = q.CreateUser(reqCtx, user)
+		resp, err = q.CreateUser(ctx, user)
 		return err
 	})
 	if err != nil {
@@ -63,13 +95,37 @@ func (m *Models) Register(reqCtx context.Context, req api.PostRegisterJSONBody)
 	return resp, nil
 }
 
+// DummyUser returns the shared test user for role, creating it on first use.
+// It has no usable password, so it can only be reached through /dummyLogin.
+func (m *Models) DummyUser(reqCtx context.Context, role string) (db.GetOrCreateUserRow, error) {
+
+	var user db.GetOrCreateUserRow
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		user, err = q.GetOrCreateUser(ctx, db.GetOrCreateUserParams{
+			Email:        "dummy-" + role + "@pvz.local",
+			PasswordHash: unusablePasswordHash,
+			Role:         role,
+		})
+		return err
+	})
+	if err != nil {
+		return db.GetOrCreateUserRow{}, err
+	}
+	return user, nil
+}
+
 func (m *Models) AddPVZ(reqCtx context.Context, req api.PVZ) (db.CreatePVZRow, error) {
+	if !m.Catalog.HasCity(req.City) {
+		return db.CreatePVZRow{}, ErrUnknownCity
+	}
 
 	var pvz db.CreatePVZRow
 
-	err := m.Transaction(reqCtx, func(q *db.Queries) error {
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
 		var err error
-		pvz, err = q.CreatePVZ(reqCtx, string(req.City))
+		pvz, err = q.CreatePVZ(ctx, req.City)
 		return err
 	})
 	if err != nil {
@@ -78,66 +134,137 @@ func (m *Models) AddPVZ(reqCtx context.Context, req api.PVZ) (db.CreatePVZRow, e
 	return pvz, nil
 }
 
-func (m *Models) AddReception(reqCtx context.Context, req api.PostReceptionsJSONBody) (db.CreateOrGetReceptionRow, error) {
+func (m *Models) AddReception(reqCtx context.Context, req api.PostReceptionsJSONBody, userID uuid.UUID) (db.CreateOrGetReceptionRow, error) {
 
 	var reception db.CreateOrGetReceptionRow
+	var city string
 
-	err := m.Transaction(reqCtx, func(q *db.Queries) error {
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		// holds off DeactivatePVZ until this reception is committed
 		var err error
-		check, err := q.HasOpenReceptions(reqCtx, uuid.UUID(req.PvzId))
+		city, err = q.LockActivePVZ(ctx, uuid.UUID(req.PvzId))
+		if err != nil {
+			return err
+		}
+		check, err := q.HasOpenReceptions(ctx, uuid.UUID(req.PvzId))
 		if check == true || err != nil {
 			return errors.New("pvz has open receptions")
 		}
-		reception, err = q.CreateOrGetReception(reqCtx, uuid.UUID(req.PvzId))
-		return err
+		reception, err = q.CreateOrGetReception(ctx, db.CreateOrGetReceptionParams{
+			PvzID:     uuid.UUID(req.PvzId),
+			CreatedBy: uuid.NullUUID{UUID: userID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		return publishReceptionEvent(ctx, q, EventReceptionOpened, reception.PvzID, city, reception.ID, userID)
 	})
 	if err != nil {
 		return db.CreateOrGetReceptionRow{}, err
 	}
+	m.Events.ReceptionOpened(city)
 	return reception, nil
 }
 
-func (m *Models) AddProduct(reqCtx context.Context, req api.PostProductsJSONBody) (db.AddProductRow, error) {
+func (m *Models) AddProduct(reqCtx context.Context, req api.PostProductsJSONBody, userID uuid.UUID) (db.AddProductRow, error) {
+	if !m.Catalog.HasProductType(req.Type) {
+		return db.AddProductRow{}, ErrUnknownProductType
+	}
 
 	var product db.AddProductRow
+	var city string
 
-	err := m.Transaction(reqCtx, func(q *db.Queries) error {
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
 		var err error
-		product, err = q.AddProduct(reqCtx, db.AddProductParams{PvzID: uuid.UUID(req.PvzId), Type: string(req.Type)})
-		return err
+		city, err = q.GetPVZCity(ctx, uuid.UUID(req.PvzId))
+		if err != nil {
+			return err
+		}
+		params := db.AddProductParams{
+			PvzID:       uuid.UUID(req.PvzId),
+			Type:        req.Type,
+			CreatedBy:   uuid.NullUUID{UUID: userID, Valid: true},
+			Barcode:     nullString(req.Barcode),
+			Sku:         nullString(req.Sku),
+			WeightGrams: nullInt32(req.WeightGrams),
+		}
+		if req.Dimensions != nil {
+			params.LengthMm = nullInt32(&req.Dimensions.LengthMm)
+			params.WidthMm = nullInt32(&req.Dimensions.WidthMm)
+			params.HeightMm = nullInt32(&req.Dimensions.HeightMm)
+		}
+		product, err = q.AddProduct(ctx, params)
+		if err != nil || product.ID == uuid.Nil {
+			return err
+		}
+		return publishProductEvent(ctx, q, EventProductAdded, params.PvzID, city, product.ReceptionID, product.ID, product.Type, userID)
 	})
+	if isUniqueViolation(err) {
+		return db.AddProductRow{}, ErrDuplicateBarcode
+	}
 	if err != nil {
 		return db.AddProductRow{}, err
 	}
+	if product.ID != uuid.Nil {
+		m.Events.ProductAdded(product.Type, city)
+	}
 	return product, nil
 }
 
-func (m *Models) CloseLastReception(reqCtx context.Context, req openapi_types.UUID) (db.CloseReceptionRow, error) {
+func (m *Models) CloseLastReception(reqCtx context.Context, req openapi_types.UUID, userID uuid.UUID) (db.CloseReceptionRow, error) {
 
 	var reception db.CloseReceptionRow
+	var city string
 
-	err := m.Transaction(reqCtx, func(q *db.Queries) error {
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
 		var err error
-		reception, err = q.CloseReception(reqCtx, uuid.UUID(req))
-		return err
+		reception, err = q.CloseReception(ctx, db.CloseReceptionParams{
+			PvzID:    uuid.UUID(req),
+			ClosedBy: uuid.NullUUID{UUID: userID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		city, err = q.GetPVZCity(ctx, reception.PvzID)
+		if err != nil {
+			return err
+		}
+		if err := recordTransition(ctx, q, reception.ID, TransitionClose, userID, ""); err != nil {
+			return err
+		}
+		return publishReceptionEvent(ctx, q, EventReceptionClosed, reception.PvzID, city, reception.ID, userID)
 	})
 	if err != nil {
 		return db.CloseReceptionRow{}, err
 	}
-	fmt.Println(reception)
+	m.Events.ReceptionClosed(city)
 	return reception, nil
 }
 
-func (m *Models) DeleteLastProduct(reqCtx context.Context, req openapi_types.UUID) error {
+func (m *Models) DeleteLastProduct(reqCtx context.Context, req openapi_types.UUID, userID uuid.UUID) error {
+
+	var product db.DeleteLastProductRow
+	var city string
 
-	err := m.Transaction(reqCtx, func(q *db.Queries) error {
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
 		var err error
-		_, err = q.DeleteLastProduct(reqCtx, uuid.UUID(req))
-		return err
+		product, err = q.DeleteLastProduct(ctx, db.DeleteLastProductParams{
+			PvzID:     uuid.UUID(req),
+			DeletedBy: uuid.NullUUID{UUID: userID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		city, err = q.GetPVZCity(ctx, uuid.UUID(req))
+		if err != nil {
+			return err
+		}
+		return publishProductEvent(ctx, q, EventProductDeleted, uuid.UUID(req), city, product.ReceptionID, product.ID, product.Type, userID)
 	})
 	if err != nil {
 		return err
 	}
+	m.Events.ProductDeleted(product.Type, city)
 	return nil
 }
 
@@ -153,18 +280,24 @@ type ReceptionWithProducts struct {
 	Products  []api.Product `json:"products"`
 }
 
-func (m *Models) GetPVZ(reqCtx context.Context, req api.GetPvzParams) ([]PVZWithReceptionsResponse, error) {
+// GetPVZ lists PVZs with their receptions. A non-empty city limits the list
+// to that city, for callers whose pvz:read grant is city-scoped.
+func (m *Models) GetPVZ(reqCtx context.Context, req api.GetPvzParams, city string) ([]PVZWithReceptionsResponse, error) {
 	var rows []db.GetPVZsWithReceptionsRow
 	var result []PVZWithReceptionsResponse
 
-	err := m.ReadOnlyTransaction(reqCtx, func(q *db.Queries) error {
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
 		var err error
 		params := ConvertPvzParamsToReceptionsParams(req)
-		rows, err = q.GetPVZsWithReceptions(reqCtx, params)
+		params.Column5 = city
+		rows, err = q.GetPVZsWithReceptions(ctx, params)
 		if err != nil {
 			return err
 		}
 
+		_, span := tracer.Start(ctx, "GetPVZ.decode")
+		defer span.End()
+
 		// Convert each row to the API response format
 		for _, row := range rows {
 			var receptions []ReceptionWithProducts
@@ -181,7 +314,7 @@ func (m *Models) GetPVZ(reqCtx context.Context, req api.GetPvzParams) ([]PVZWith
 				PVZ: api.PVZ{
 					Id:               &pvzID,
 					RegistrationDate: &row.RegistrationDate.Time,
-					City:             api.PVZCity(row.City),
+					City:             row.City,
 				},
 				Receptions: receptions,
 			}
@@ -214,6 +347,11 @@ func ConvertPvzParamsToReceptionsParams(src api.GetPvzParams) db.GetPVZsWithRece
 		Column4: int32(page),
 	}
 
+	// Deleted products are tombstones, hidden unless asked for
+	if src.IncludeDeleted != nil {
+		params.Column6 = *src.IncludeDeleted
+	}
+
 	// Handle date parameters
 	if src.StartDate != nil {
 		params.DateTime = sql.NullTime{
@@ -237,9 +375,9 @@ func (m *Models) GetPVZ(reqCtx context.Context, req api.GetPvzParams) ([]db.GetP
 
 	var pvz []db.GetPVZsWithReceptionsRow
 
-	err := m.ReadOnlyTransaction(reqCtx, func(q *db.Queries) error {
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
 		var err error
-		pvz, err = q.GetPVZsWithReceptions(reqCtx, ConvertPvzParamsToReceptionsParams(req))
+		pvz, err = q.GetPVZsWithReceptions(ctx, ConvertPvzParamsToReceptionsParams(req))
 		return err
 	})
 	if err != nil {
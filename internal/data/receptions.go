@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/db"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ReceptionDetail is a reception together with its products, newest first.
+type ReceptionDetail struct {
+	Reception db.GetReceptionRow
+	Products  []db.ListReceptionProductsRow
+}
+
+func (m *Models) GetReception(reqCtx context.Context, receptionID uuid.UUID, includeDeleted bool) (ReceptionDetail, error) {
+
+	var detail ReceptionDetail
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		detail.Reception, err = q.GetReception(ctx, receptionID)
+		if err != nil {
+			return err
+		}
+		detail.Products, err = q.ListReceptionProducts(ctx, db.ListReceptionProductsParams{
+			ReceptionID: receptionID,
+			Column2:     includeDeleted,
+		})
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return ReceptionDetail{}, ErrRecordNotFound
+	}
+	if err != nil {
+		return ReceptionDetail{}, err
+	}
+	return detail, nil
+}
+
+// ReceptionPVZ returns the PVZ receptionID belongs to.
+func (m *Models) ReceptionPVZ(reqCtx context.Context, receptionID uuid.UUID) (uuid.UUID, error) {
+
+	var pvzID uuid.UUID
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		reception, err := q.GetReception(ctx, receptionID)
+		pvzID = reception.PvzID
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, ErrRecordNotFound
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return pvzID, nil
+}
+
+// GetCurrentReception returns the reception in progress at pvzID with its live products.
+func (m *Models) GetCurrentReception(reqCtx context.Context, pvzID uuid.UUID) (ReceptionDetail, error) {
+
+	var detail ReceptionDetail
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		current, err := q.GetCurrentReception(ctx, pvzID)
+		if err != nil {
+			return err
+		}
+		detail.Reception = db.GetReceptionRow(current)
+		detail.Products, err = q.ListReceptionProducts(ctx, db.ListReceptionProductsParams{ReceptionID: current.ID})
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return ReceptionDetail{}, ErrRecordNotFound
+	}
+	if err != nil {
+		return ReceptionDetail{}, err
+	}
+	return detail, nil
+}
+
+// ListReceptions returns one page of the reception history of pvzID, newest
+// first, and the cursor of the next page ("" on the last page).
+func (m *Models) ListReceptions(reqCtx context.Context, pvzID uuid.UUID, req api.GetPvzPvzIdReceptionsParams) ([]db.ListReceptionsRow, string, error) {
+	limit := 20
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+
+	params := db.ListReceptionsParams{
+		PvzID: pvzID,
+		Limit: int32(limit + 1), // one extra row tells whether there is a next page
+	}
+	if req.Status != nil {
+		params.Column2 = string(*req.Status)
+	}
+	if req.StartDate != nil {
+		params.DateTime = sql.NullTime{Time: *req.StartDate, Valid: true}
+	}
+	if req.EndDate != nil {
+		params.DateTime_2 = sql.NullTime{Time: *req.EndDate, Valid: true}
+	}
+	if req.Cursor != nil && *req.Cursor != "" {
+		after, id, err := decodeReceptionCursor(*req.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		params.Column5, params.Column6, params.Column7 = true, after, id
+	}
+
+	var receptions []db.ListReceptionsRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		receptions, err = q.ListReceptions(ctx, params)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(receptions) > limit {
+		receptions = receptions[:limit]
+		last := receptions[limit-1]
+		next = encodeReceptionCursor(last.DateTime.Time, last.ID)
+	}
+	return receptions, next, nil
+}
+
+func encodeReceptionCursor(dateTime time.Time, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(dateTime.UTC().Format(time.RFC3339Nano) + "|" + id.String()))
+}
+
+func decodeReceptionCursor(cursor string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+	dateTime, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+	after, err := time.Parse(time.RFC3339Nano, dateTime)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+	receptionID, err := uuid.Parse(id)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+	return after, receptionID, nil
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/wisp167/pvz/internal/db"
+	"github.com/wisp167/pvz/internal/policy"
+)
+
+var ErrInvalidGrant = errors.New("unknown, duplicate or badly scoped permission")
+
+// Role is a role together with the permissions it grants.
+type Role struct {
+	Name        string
+	Description string
+	Grants      []policy.Grant
+}
+
+// LoadPolicy rereads the role table into m.Policy.
+func (m *Models) LoadPolicy(reqCtx context.Context) error {
+
+	var rows []db.ListRolePermissionsRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		rows, err = q.ListRolePermissions(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	grants := make(map[string][]policy.Grant)
+	for _, row := range rows {
+		if _, ok := grants[row.Role]; !ok {
+			grants[row.Role] = nil // roles without grants still exist
+		}
+		if row.Permission.Valid {
+			grants[row.Role] = append(grants[row.Role], policy.Grant{
+				Permission: row.Permission.String,
+				Scope:      policy.Scope(row.Scope.String),
+			})
+		}
+	}
+	m.Policy.Load(grants)
+	return nil
+}
+
+func (m *Models) ListRoles(reqCtx context.Context) ([]Role, error) {
+
+	var roles []db.ListRolesRow
+	var perms []db.ListRolePermissionsRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		roles, err = q.ListRoles(ctx)
+		if err != nil {
+			return err
+		}
+		perms, err = q.ListRolePermissions(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make(map[string][]policy.Grant)
+	for _, row := range perms {
+		if row.Permission.Valid {
+			grants[row.Role] = append(grants[row.Role], policy.Grant{
+				Permission: row.Permission.String,
+				Scope:      policy.Scope(row.Scope.String),
+			})
+		}
+	}
+
+	result := make([]Role, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, Role{Name: role.Name, Description: role.Description, Grants: grants[role.Name]})
+	}
+	return result, nil
+}
+
+// SaveRole creates role or replaces its description and grants, then reloads
+// the policy so the change takes effect immediately on this instance.
+func (m *Models) SaveRole(reqCtx context.Context, role Role) error {
+	seen := make(map[string]bool, len(role.Grants))
+	for _, grant := range role.Grants {
+		if !policy.KnownPermission(grant.Permission) || !grant.Scope.Valid() || seen[grant.Permission] {
+			return ErrInvalidGrant
+		}
+		seen[grant.Permission] = true
+	}
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		err := q.UpsertRole(ctx, db.UpsertRoleParams{Name: role.Name, Description: role.Description})
+		if err != nil {
+			return err
+		}
+		if err := q.DeleteRolePermissions(ctx, role.Name); err != nil {
+			return err
+		}
+		for _, grant := range role.Grants {
+			err := q.AddRolePermission(ctx, db.AddRolePermissionParams{
+				Role:       role.Name,
+				Permission: grant.Permission,
+				Scope:      string(grant.Scope),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return m.LoadPolicy(reqCtx)
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/wisp167/pvz/internal/db"
+)
+
+// AssignStaff attaches an employee to a PVZ. ErrRecordNotFound is returned
+// when the PVZ does not exist or the user is not an employee.
+func (m *Models) AssignStaff(reqCtx context.Context, pvzID, userID, assignedBy uuid.UUID) (db.PvzStaff, error) {
+
+	var staff db.PvzStaff
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		staff, err = q.AssignStaff(ctx, db.AssignStaffParams{
+			PvzID:      pvzID,
+			UserID:     userID,
+			AssignedBy: uuid.NullUUID{UUID: assignedBy, Valid: true},
+		})
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) || isForeignKeyViolation(err) {
+		return db.PvzStaff{}, ErrRecordNotFound
+	}
+	if err != nil {
+		return db.PvzStaff{}, err
+	}
+	return staff, nil
+}
+
+func (m *Models) UnassignStaff(reqCtx context.Context, pvzID, userID uuid.UUID) error {
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		n, err := q.UnassignStaff(ctx, db.UnassignStaffParams{PvzID: pvzID, UserID: userID})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrRecordNotFound
+		}
+		return nil
+	})
+	return err
+}
+
+func (m *Models) ListStaff(reqCtx context.Context, pvzID uuid.UUID) ([]db.ListStaffRow, error) {
+
+	var staff []db.ListStaffRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		staff, err = q.ListStaff(ctx, pvzID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return staff, nil
+}
+
+func (m *Models) IsStaffAssigned(reqCtx context.Context, pvzID, userID uuid.UUID) (bool, error) {
+	return m.PVZ.Queries.IsStaffAssigned(reqCtx, db.IsStaffAssignedParams{PvzID: pvzID, UserID: userID})
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wisp167/pvz/internal/db"
+)
+
+// Types of the events streamed to dashboards. They are published with
+// NOTIFY on EventsChannel by the transaction that makes the change, so every
+// server instance hears them once, and only after the commit.
+const (
+	EventReceptionOpened    = "reception.opened"
+	EventReceptionClosed    = "reception.closed"
+	EventReceptionCancelled = "reception.cancelled"
+	EventReceptionVerified  = "reception.verified"
+	EventProductAdded       = "product.added"
+	EventProductDeleted     = "product.deleted"
+)
+
+// EventsChannel is the Postgres channel the events are NOTIFYed on.
+const EventsChannel = "pvz_events"
+
+// EventFilter selects the events of one PVZ, or of every PVZ in a city.
+type EventFilter struct {
+	PvzID uuid.UUID
+	City  string
+}
+
+// Matches reports whether an event of pvzID in city passes the filter.
+func (f EventFilter) Matches(pvzID uuid.UUID, city string) bool {
+	if f.PvzID != uuid.Nil {
+		return f.PvzID == pvzID
+	}
+	return f.City == city
+}
+
+// EventsSince returns up to limit events matching filter with an id greater
+// than afterID, oldest first.
+func (m *Models) EventsSince(reqCtx context.Context, filter EventFilter, afterID int64, limit int) ([]db.PvzEvent, error) {
+
+	var events []db.PvzEvent
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		params := db.ListEventsSinceParams{AfterID: afterID, RowLimit: int32(limit)}
+		if filter.PvzID != uuid.Nil {
+			params.PvzID = uuid.NullUUID{UUID: filter.PvzID, Valid: true}
+		} else {
+			params.City = sql.NullString{String: filter.City, Valid: true}
+		}
+		var err error
+		events, err = q.ListEventsSince(ctx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// PurgeEvents deletes the events created before the given time, after which
+// clients can no longer resume from them. It returns how many were deleted.
+func (m *Models) PurgeEvents(reqCtx context.Context, before time.Time) (int64, error) {
+
+	var deleted int64
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		deleted, err = q.DeleteEventsBefore(ctx, before)
+		return err
+	})
+	return deleted, err
+}
+
+// Publishing takes the event stream lock and holds it until the transaction
+// ends, so ids follow commit order and a stream can resume after the last id
+// it sent. To keep that lock short, publishing is the last thing a
+// transaction does.
+
+// publishReceptionEvent records a reception.* event in the transaction of q.
+func publishReceptionEvent(ctx context.Context, q *db.Queries, eventType string, pvzID uuid.UUID, city string, receptionID, actorID uuid.UUID) error {
+	if err := q.LockEventStream(ctx); err != nil {
+		return err
+	}
+	return q.PublishEvent(ctx, db.PublishEventParams{
+		Type:        eventType,
+		PvzID:       pvzID,
+		City:        city,
+		ReceptionID: receptionID,
+		ActorID:     uuid.NullUUID{UUID: actorID, Valid: true},
+	})
+}
+
+// publishProductEvent records a product.* event in the transaction of q.
+func publishProductEvent(ctx context.Context, q *db.Queries, eventType string, pvzID uuid.UUID, city string, receptionID, productID uuid.UUID, productType string, actorID uuid.UUID) error {
+	if err := q.LockEventStream(ctx); err != nil {
+		return err
+	}
+	return q.PublishEvent(ctx, db.PublishEventParams{
+		Type:        eventType,
+		PvzID:       pvzID,
+		City:        city,
+		ReceptionID: receptionID,
+		ProductID:   uuid.NullUUID{UUID: productID, Valid: true},
+		ProductType: sql.NullString{String: productType, Valid: true},
+		ActorID:     uuid.NullUUID{UUID: actorID, Valid: true},
+	})
+}
+
+// publishProductEvents records a product.* event for each product of a batch
+// in the transaction of q.
+func publishProductEvents(ctx context.Context, q *db.Queries, params db.PublishProductEventsParams) error {
+	if err := q.LockEventStream(ctx); err != nil {
+		return err
+	}
+	return q.PublishProductEvents(ctx, params)
+}
@@ -0,0 +1,107 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/internal/db"
+	"github.com/wisp167/pvz/internal/migrate"
+	"github.com/wisp167/pvz/internal/testdb"
+)
+
+func newTestModels(t *testing.T) Models {
+	sqlDB, _ := testdb.New(t)
+	migrations, err := migrate.New(sqlDB)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	if _, err := migrations.Up(context.Background()); !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	m, err := NewModels(sqlDB)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return m
+}
+
+// Without the stream lock, A takes an id, B takes the next one and commits
+// first: a client that saw B and resumes after it never gets A.
+func TestEventIdsFollowCommitOrder(t *testing.T) {
+	ctx := context.Background()
+	m := newTestModels(t)
+
+	var pvzID uuid.UUID
+	err := m.PVZ.DB.QueryRow(`INSERT INTO pvz (city) VALUES ('Казань') RETURNING id`).Scan(&pvzID)
+	if !assert.NoError(t, err) {
+		return
+	}
+	publish := func(ctx context.Context, q *db.Queries) error {
+		return publishReceptionEvent(ctx, q, EventReceptionOpened, pvzID, "Казань", uuid.New(), uuid.New())
+	}
+
+	published, commitA := make(chan struct{}), make(chan struct{})
+	doneA := make(chan error, 1)
+	go func() {
+		doneA <- m.Transaction(ctx, func(ctx context.Context, q *db.Queries) error {
+			if err := publish(ctx, q); err != nil {
+				return err
+			}
+			close(published)
+			<-commitA
+			return nil
+		})
+	}()
+	select {
+	case <-published:
+	case err := <-doneA:
+		t.Fatalf("A: %v", err)
+	}
+
+	doneB := make(chan error, 1)
+	go func() { doneB <- m.Transaction(ctx, publish) }()
+
+	// B waits for the stream lock A holds
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var waiting int
+		err := m.PVZ.DB.QueryRow(`SELECT COUNT(*) FROM pg_locks WHERE locktype = 'advisory' AND NOT granted`).Scan(&waiting)
+		if !assert.NoError(t, err) {
+			return
+		}
+		if waiting == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("B is not waiting for the stream lock")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-doneB:
+		t.Fatalf("B committed before A: %v", err)
+	default:
+	}
+
+	close(commitA)
+	assert.NoError(t, <-doneA)
+	assert.NoError(t, <-doneB)
+
+	filter := EventFilter{PvzID: pvzID}
+	events, err := m.EventsSince(ctx, filter, 0, 10)
+	if !assert.NoError(t, err) || !assert.Len(t, events, 2) {
+		return
+	}
+	a, b := events[0], events[1]
+	assert.Less(t, a.ID, b.ID)
+
+	// resuming between the two still gets B
+	resumed, err := m.EventsSince(ctx, filter, a.ID, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, resumed, 1) {
+		assert.Equal(t, b.ID, resumed[0].ID)
+	}
+}
@@ -0,0 +1,135 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wisp167/pvz/internal/db"
+	"github.com/wisp167/pvz/internal/helpers"
+)
+
+const RefreshTokenTTL = 14 * 24 * time.Hour
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reused")
+)
+
+// IssueRefreshToken starts a new token family for userID and returns its first token.
+func (m *Models) IssueRefreshToken(reqCtx context.Context, userID uuid.UUID) (string, error) {
+
+	token, hash, err := helpers.NewOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		_, err := q.CreateRefreshToken(ctx, db.CreateRefreshTokenParams{
+			UserID:    userID,
+			FamilyID:  uuid.New(),
+			TokenHash: hash,
+			ExpiresAt: time.Now().Add(RefreshTokenTTL),
+		})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RotateRefreshToken exchanges token for a new one in the same family.
+// Presenting an already rotated token revokes the whole family, since either
+// the client or an attacker is holding a stolen copy.
+func (m *Models) RotateRefreshToken(reqCtx context.Context, token string) (db.GetUserByIDRow, string, error) {
+
+	var user db.GetUserByIDRow
+	var reused bool
+
+	newToken, newHash, err := helpers.NewOpaqueToken()
+	if err != nil {
+		return db.GetUserByIDRow{}, "", err
+	}
+
+	err = m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		current, err := q.GetRefreshTokenForUpdate(ctx, helpers.HashOpaqueToken(token))
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidRefreshToken
+		}
+		if err != nil {
+			return err
+		}
+
+		if current.RevokedAt.Valid {
+			// Commit the family revocation, report the reuse after the transaction
+			reused = true
+			return q.RevokeRefreshTokenFamily(ctx, current.FamilyID)
+		}
+		if time.Now().After(current.ExpiresAt) {
+			return ErrInvalidRefreshToken
+		}
+
+		user, err = q.GetUserByID(ctx, current.UserID)
+		if err != nil {
+			return err
+		}
+
+		nextID, err := q.CreateRefreshToken(ctx, db.CreateRefreshTokenParams{
+			UserID:    current.UserID,
+			FamilyID:  current.FamilyID,
+			TokenHash: newHash,
+			ExpiresAt: time.Now().Add(RefreshTokenTTL),
+		})
+		if err != nil {
+			return err
+		}
+		return q.RevokeRefreshToken(ctx, db.RevokeRefreshTokenParams{
+			ID:         current.ID,
+			ReplacedBy: uuid.NullUUID{UUID: nextID, Valid: true},
+		})
+	})
+	if err != nil {
+		return db.GetUserByIDRow{}, "", err
+	}
+	if reused {
+		return db.GetUserByIDRow{}, "", ErrRefreshTokenReused
+	}
+	return user, newToken, nil
+}
+
+// Logout revokes the access token jti until its expiry and, if given, the
+// family of refreshToken. A refresh token issued to another user than userID
+// is rejected with ErrInvalidRefreshToken.
+func (m *Models) Logout(reqCtx context.Context, userID, jti uuid.UUID, expiresAt time.Time, refreshToken string) error {
+
+	return m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		if err := q.DeleteExpiredRevokedTokens(ctx); err != nil {
+			return err
+		}
+		if err := q.RevokeAccessToken(ctx, db.RevokeAccessTokenParams{Jti: jti, ExpiresAt: expiresAt}); err != nil {
+			return err
+		}
+		if refreshToken == "" {
+			return nil
+		}
+
+		current, err := q.GetRefreshTokenForUpdate(ctx, helpers.HashOpaqueToken(refreshToken))
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidRefreshToken
+		}
+		if err != nil {
+			return err
+		}
+		if current.UserID != userID {
+			return ErrInvalidRefreshToken
+		}
+		return q.RevokeRefreshTokenFamily(ctx, current.FamilyID)
+	})
+}
+
+func (m *Models) IsAccessTokenRevoked(reqCtx context.Context, jti uuid.UUID) (bool, error) {
+	return m.PVZ.Queries.IsAccessTokenRevoked(reqCtx, jti)
+}
@@ -0,0 +1,180 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wisp167/pvz/internal/db"
+)
+
+// Reception statuses. A reception is in progress until it is closed or
+// cancelled; a closed one can be verified or reopened.
+const (
+	ReceptionInProgress = "in_progress"
+	ReceptionClosed     = "closed"
+	ReceptionCancelled  = "cancelled"
+	ReceptionVerified   = "verified"
+)
+
+// ReceptionTransition names a change of reception status.
+type ReceptionTransition string
+
+const (
+	TransitionClose  ReceptionTransition = "close"
+	TransitionCancel ReceptionTransition = "cancel"
+	TransitionReopen ReceptionTransition = "reopen"
+	TransitionVerify ReceptionTransition = "verify"
+)
+
+// receptionTransitions is the reception state machine: the only status each
+// transition may start from and the status it leads to.
+var receptionTransitions = map[ReceptionTransition]struct{ from, to string }{
+	TransitionClose:  {ReceptionInProgress, ReceptionClosed},
+	TransitionCancel: {ReceptionInProgress, ReceptionCancelled},
+	TransitionReopen: {ReceptionClosed, ReceptionInProgress},
+	TransitionVerify: {ReceptionClosed, ReceptionVerified},
+}
+
+// transitionEvents is the stream event each transition publishes; a reopened
+// reception is announced like a newly opened one.
+var transitionEvents = map[ReceptionTransition]string{
+	TransitionClose:  EventReceptionClosed,
+	TransitionCancel: EventReceptionCancelled,
+	TransitionReopen: EventReceptionOpened,
+	TransitionVerify: EventReceptionVerified,
+}
+
+var (
+	ErrInvalidTransition = errors.New("transition not allowed from the reception's status")
+	ErrReasonRequired    = errors.New("reason is required")
+)
+
+// TransitionReception applies transition to receptionID and records it in
+// the reception's history with actorID and reason. It fails with
+// ErrInvalidTransition when the reception is in another status. Reopening
+// needs a reason and an active PVZ without another reception in progress
+// (ErrPVZHasOpenReception).
+func (m *Models) TransitionReception(reqCtx context.Context, receptionID uuid.UUID, transition ReceptionTransition, actorID uuid.UUID, reason string) (db.SetReceptionStatusRow, error) {
+	step, ok := receptionTransitions[transition]
+	if !ok {
+		return db.SetReceptionStatusRow{}, fmt.Errorf("unknown reception transition %q", transition)
+	}
+	reason = strings.TrimSpace(reason)
+	if transition == TransitionReopen && reason == "" {
+		return db.SetReceptionStatusRow{}, ErrReasonRequired
+	}
+
+	var reception db.SetReceptionStatusRow
+	var city string
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		current, err := q.GetReceptionForUpdate(ctx, receptionID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRecordNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if current.Status != step.from {
+			return ErrInvalidTransition
+		}
+
+		params := db.SetReceptionStatusParams{
+			ID:       receptionID,
+			Status:   step.to,
+			ClosedBy: current.ClosedBy,
+			ClosedAt: current.ClosedAt,
+		}
+		switch transition {
+		case TransitionClose, TransitionCancel:
+			params.ClosedBy = uuid.NullUUID{UUID: actorID, Valid: true}
+			params.ClosedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		case TransitionReopen:
+			// same checks as opening a new reception
+			city, err = q.LockActivePVZ(ctx, current.PvzID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w: pvz is deactivated", ErrInvalidTransition)
+			}
+			if err != nil {
+				return err
+			}
+			open, err := q.HasOpenReceptions(ctx, current.PvzID)
+			if err != nil {
+				return err
+			}
+			if open {
+				return ErrPVZHasOpenReception
+			}
+			params.ClosedBy = uuid.NullUUID{}
+			params.ClosedAt = sql.NullTime{}
+		}
+
+		reception, err = q.SetReceptionStatus(ctx, params)
+		if err != nil {
+			return err
+		}
+		if transition != TransitionReopen {
+			// reopening has the city from locking the PVZ
+			if city, err = q.GetPVZCity(ctx, current.PvzID); err != nil {
+				return err
+			}
+		}
+		if err := recordTransition(ctx, q, receptionID, transition, actorID, reason); err != nil {
+			return err
+		}
+		return publishReceptionEvent(ctx, q, transitionEvents[transition], current.PvzID, city, receptionID, actorID)
+	})
+	if err != nil {
+		return db.SetReceptionStatusRow{}, err
+	}
+
+	switch transition {
+	case TransitionClose:
+		m.Events.ReceptionClosed(city)
+	case TransitionCancel:
+		m.Events.ReceptionCancelled(city)
+	case TransitionReopen:
+		m.Events.ReceptionOpened(city)
+	case TransitionVerify:
+		m.Events.ReceptionVerified(city)
+	}
+	return reception, nil
+}
+
+// ReceptionHistory returns the status changes of receptionID, oldest first.
+func (m *Models) ReceptionHistory(reqCtx context.Context, receptionID uuid.UUID) ([]db.ReceptionTransition, error) {
+
+	var history []db.ReceptionTransition
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		if _, err := q.GetReception(ctx, receptionID); err != nil {
+			return err
+		}
+		var err error
+		history, err = q.ListReceptionTransitions(ctx, receptionID)
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func recordTransition(ctx context.Context, q *db.Queries, receptionID uuid.UUID, transition ReceptionTransition, actorID uuid.UUID, reason string) error {
+	step := receptionTransitions[transition]
+	return q.AddReceptionTransition(ctx, db.AddReceptionTransitionParams{
+		ReceptionID: receptionID,
+		FromStatus:  step.from,
+		ToStatus:    step.to,
+		Reason:      nullString(&reason),
+		ActorID:     uuid.NullUUID{UUID: actorID, Valid: true},
+	})
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/db"
+)
+
+var (
+	ErrDuplicateEmail = errors.New("user with this email already exists")
+	ErrUnknownRole    = errors.New("unknown role")
+)
+
+func (m *Models) ListUsers(reqCtx context.Context, req api.GetUsersParams) ([]db.ListUsersRow, error) {
+	page := 1
+	if req.Page != nil {
+		page = *req.Page
+	}
+
+	limit := 20
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+
+	var users []db.ListUsersRow
+
+	err := m.ReadOnlyTransaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		users, err = q.ListUsers(ctx, db.ListUsersParams{Limit: int32(limit), Column2: int32(page)})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// CreateUser adds a user with any role known to the policy. Unlike Register it
+// is reachable only by holders of user:manage.
+func (m *Models) CreateUser(reqCtx context.Context, req api.PostUsersJSONBody) (db.CreateUserRow, error) {
+	if req.City != nil && *req.City != "" && !m.Catalog.HasCity(*req.City) {
+		return db.CreateUserRow{}, ErrUnknownCity
+	}
+
+	var user db.CreateUserRow
+
+	hash, err := m.Hasher.Hash(req.Password)
+	if err != nil {
+		return db.CreateUserRow{}, err
+	}
+
+	err = m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		var err error
+		user, err = q.CreateUser(ctx, db.CreateUserParams{
+			Email:        string(req.Email),
+			PasswordHash: hash,
+			Role:         req.Role,
+			City:         nullString(req.City),
+		})
+		return err
+	})
+	if isUniqueViolation(err) {
+		return db.CreateUserRow{}, ErrDuplicateEmail
+	}
+	if isForeignKeyViolation(err) {
+		return db.CreateUserRow{}, ErrUnknownRole
+	}
+	if err != nil {
+		return db.CreateUserRow{}, err
+	}
+	return user, nil
+}
+
+// UpdateUser changes the role and city of a user. Omitted fields keep their
+// current value; an empty city clears it.
+func (m *Models) UpdateUser(reqCtx context.Context, userID uuid.UUID, req api.PatchUsersUserIdJSONBody) (db.UpdateUserRow, error) {
+	if req.City != nil && *req.City != "" && !m.Catalog.HasCity(*req.City) {
+		return db.UpdateUserRow{}, ErrUnknownCity
+	}
+
+	var user db.UpdateUserRow
+
+	err := m.Transaction(reqCtx, func(ctx context.Context, q *db.Queries) error {
+		current, err := q.GetUserByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		params := db.UpdateUserParams{ID: userID, Role: current.Role, City: current.City}
+		if req.Role != nil {
+			params.Role = *req.Role
+		}
+		if req.City != nil {
+			params.City = nullString(req.City)
+		}
+
+		user, err = q.UpdateUser(ctx, params)
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.UpdateUserRow{}, ErrRecordNotFound
+	}
+	if isForeignKeyViolation(err) {
+		return db.UpdateUserRow{}, ErrUnknownRole
+	}
+	if err != nil {
+		return db.UpdateUserRow{}, err
+	}
+	return user, nil
+}
+
+// PVZCity returns the city of a PVZ, used to enforce city-scoped permissions.
+func (m *Models) PVZCity(reqCtx context.Context, pvzID uuid.UUID) (string, error) {
+	city, err := m.PVZ.Queries.GetPVZCity(reqCtx, pvzID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrRecordNotFound
+	}
+	return city, err
+}
+
+func nullString(s *string) sql.NullString {
+	if s == nil || *s == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+func nullInt32(n *int) sql.NullInt32 {
+	if n == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*n), Valid: true}
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
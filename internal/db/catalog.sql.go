@@ -0,0 +1,126 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: catalog.sql
+
+package db
+
+import (
+	"context"
+)
+
+const listCities = `-- name: ListCities :many
+SELECT name, active
+FROM cities
+ORDER BY name
+`
+
+type ListCitiesRow struct {
+	Name   string `db:"name" json:"name"`
+	Active bool   `db:"active" json:"active"`
+}
+
+func (q *Queries) ListCities(ctx context.Context) ([]ListCitiesRow, error) {
+	rows, err := q.query(ctx, q.listCitiesStmt, listCities)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCitiesRow
+	for rows.Next() {
+		var i ListCitiesRow
+		if err := rows.Scan(&i.Name, &i.Active); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductTypes = `-- name: ListProductTypes :many
+SELECT name, active
+FROM product_types
+ORDER BY name
+`
+
+type ListProductTypesRow struct {
+	Name   string `db:"name" json:"name"`
+	Active bool   `db:"active" json:"active"`
+}
+
+func (q *Queries) ListProductTypes(ctx context.Context) ([]ListProductTypesRow, error) {
+	rows, err := q.query(ctx, q.listProductTypesStmt, listProductTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductTypesRow
+	for rows.Next() {
+		var i ListProductTypesRow
+		if err := rows.Scan(&i.Name, &i.Active); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertCity = `-- name: UpsertCity :one
+INSERT INTO cities (name, active)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET active = EXCLUDED.active
+RETURNING name, active
+`
+
+type UpsertCityParams struct {
+	Name   string `db:"name" json:"name"`
+	Active bool   `db:"active" json:"active"`
+}
+
+type UpsertCityRow struct {
+	Name   string `db:"name" json:"name"`
+	Active bool   `db:"active" json:"active"`
+}
+
+func (q *Queries) UpsertCity(ctx context.Context, arg UpsertCityParams) (UpsertCityRow, error) {
+	row := q.queryRow(ctx, q.upsertCityStmt, upsertCity, arg.Name, arg.Active)
+	var i UpsertCityRow
+	err := row.Scan(&i.Name, &i.Active)
+	return i, err
+}
+
+const upsertProductType = `-- name: UpsertProductType :one
+INSERT INTO product_types (name, active)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET active = EXCLUDED.active
+RETURNING name, active
+`
+
+type UpsertProductTypeParams struct {
+	Name   string `db:"name" json:"name"`
+	Active bool   `db:"active" json:"active"`
+}
+
+type UpsertProductTypeRow struct {
+	Name   string `db:"name" json:"name"`
+	Active bool   `db:"active" json:"active"`
+}
+
+func (q *Queries) UpsertProductType(ctx context.Context, arg UpsertProductTypeParams) (UpsertProductTypeRow, error) {
+	row := q.queryRow(ctx, q.upsertProductTypeStmt, upsertProductType, arg.Name, arg.Active)
+	var i UpsertProductTypeRow
+	err := row.Scan(&i.Name, &i.Active)
+	return i, err
+}
@@ -27,30 +27,195 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addProductStmt, err = db.PrepareContext(ctx, addProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query AddProduct: %w", err)
 	}
+	if q.addProductsStmt, err = db.PrepareContext(ctx, addProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query AddProducts: %w", err)
+	}
+	if q.addReceptionTransitionStmt, err = db.PrepareContext(ctx, addReceptionTransition); err != nil {
+		return nil, fmt.Errorf("error preparing query AddReceptionTransition: %w", err)
+	}
+	if q.addRolePermissionStmt, err = db.PrepareContext(ctx, addRolePermission); err != nil {
+		return nil, fmt.Errorf("error preparing query AddRolePermission: %w", err)
+	}
+	if q.assignStaffStmt, err = db.PrepareContext(ctx, assignStaff); err != nil {
+		return nil, fmt.Errorf("error preparing query AssignStaff: %w", err)
+	}
+	if q.claimIdempotencyKeyStmt, err = db.PrepareContext(ctx, claimIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimIdempotencyKey: %w", err)
+	}
 	if q.closeReceptionStmt, err = db.PrepareContext(ctx, closeReception); err != nil {
 		return nil, fmt.Errorf("error preparing query CloseReception: %w", err)
 	}
+	if q.completeIdempotencyKeyStmt, err = db.PrepareContext(ctx, completeIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query CompleteIdempotencyKey: %w", err)
+	}
+	if q.countOpenReceptionsByCityStmt, err = db.PrepareContext(ctx, countOpenReceptionsByCity); err != nil {
+		return nil, fmt.Errorf("error preparing query CountOpenReceptionsByCity: %w", err)
+	}
 	if q.createOrGetReceptionStmt, err = db.PrepareContext(ctx, createOrGetReception); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateOrGetReception: %w", err)
 	}
 	if q.createPVZStmt, err = db.PrepareContext(ctx, createPVZ); err != nil {
 		return nil, fmt.Errorf("error preparing query CreatePVZ: %w", err)
 	}
+	if q.createRefreshTokenStmt, err = db.PrepareContext(ctx, createRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRefreshToken: %w", err)
+	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
+	if q.deactivatePVZStmt, err = db.PrepareContext(ctx, deactivatePVZ); err != nil {
+		return nil, fmt.Errorf("error preparing query DeactivatePVZ: %w", err)
+	}
+	if q.deleteEventsBeforeStmt, err = db.PrepareContext(ctx, deleteEventsBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEventsBefore: %w", err)
+	}
+	if q.deleteExpiredIdempotencyKeysStmt, err = db.PrepareContext(ctx, deleteExpiredIdempotencyKeys); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredIdempotencyKeys: %w", err)
+	}
+	if q.deleteExpiredRevokedTokensStmt, err = db.PrepareContext(ctx, deleteExpiredRevokedTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredRevokedTokens: %w", err)
+	}
 	if q.deleteLastProductStmt, err = db.PrepareContext(ctx, deleteLastProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteLastProduct: %w", err)
 	}
+	if q.deleteRolePermissionsStmt, err = db.PrepareContext(ctx, deleteRolePermissions); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRolePermissions: %w", err)
+	}
+	if q.getCurrentReceptionStmt, err = db.PrepareContext(ctx, getCurrentReception); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCurrentReception: %w", err)
+	}
+	if q.getIdempotencyKeyStmt, err = db.PrepareContext(ctx, getIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIdempotencyKey: %w", err)
+	}
+	if q.getOrCreateUserStmt, err = db.PrepareContext(ctx, getOrCreateUser); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOrCreateUser: %w", err)
+	}
+	if q.getPVZCityStmt, err = db.PrepareContext(ctx, getPVZCity); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPVZCity: %w", err)
+	}
+	if q.getPVZWithReceptionsStmt, err = db.PrepareContext(ctx, getPVZWithReceptions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPVZWithReceptions: %w", err)
+	}
 	if q.getPVZsWithReceptionsStmt, err = db.PrepareContext(ctx, getPVZsWithReceptions); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPVZsWithReceptions: %w", err)
 	}
-	if q.getUserByCredentialsStmt, err = db.PrepareContext(ctx, getUserByCredentials); err != nil {
-		return nil, fmt.Errorf("error preparing query GetUserByCredentials: %w", err)
+	if q.getReceptionStmt, err = db.PrepareContext(ctx, getReception); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReception: %w", err)
+	}
+	if q.getReceptionForUpdateStmt, err = db.PrepareContext(ctx, getReceptionForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReceptionForUpdate: %w", err)
+	}
+	if q.getRefreshTokenForUpdateStmt, err = db.PrepareContext(ctx, getRefreshTokenForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRefreshTokenForUpdate: %w", err)
+	}
+	if q.getUserByEmailStmt, err = db.PrepareContext(ctx, getUserByEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserByEmail: %w", err)
+	}
+	if q.getUserByIDStmt, err = db.PrepareContext(ctx, getUserByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserByID: %w", err)
 	}
 	if q.hasOpenReceptionsStmt, err = db.PrepareContext(ctx, hasOpenReceptions); err != nil {
 		return nil, fmt.Errorf("error preparing query HasOpenReceptions: %w", err)
 	}
+	if q.importPVZStmt, err = db.PrepareContext(ctx, importPVZ); err != nil {
+		return nil, fmt.Errorf("error preparing query ImportPVZ: %w", err)
+	}
+	if q.isAccessTokenRevokedStmt, err = db.PrepareContext(ctx, isAccessTokenRevoked); err != nil {
+		return nil, fmt.Errorf("error preparing query IsAccessTokenRevoked: %w", err)
+	}
+	if q.isStaffAssignedStmt, err = db.PrepareContext(ctx, isStaffAssigned); err != nil {
+		return nil, fmt.Errorf("error preparing query IsStaffAssigned: %w", err)
+	}
+	if q.listCitiesStmt, err = db.PrepareContext(ctx, listCities); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCities: %w", err)
+	}
+	if q.listEventsSinceStmt, err = db.PrepareContext(ctx, listEventsSince); err != nil {
+		return nil, fmt.Errorf("error preparing query ListEventsSince: %w", err)
+	}
+	if q.listLiveBarcodesStmt, err = db.PrepareContext(ctx, listLiveBarcodes); err != nil {
+		return nil, fmt.Errorf("error preparing query ListLiveBarcodes: %w", err)
+	}
+	if q.listProductTypesStmt, err = db.PrepareContext(ctx, listProductTypes); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductTypes: %w", err)
+	}
+	if q.listReceptionProductsStmt, err = db.PrepareContext(ctx, listReceptionProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReceptionProducts: %w", err)
+	}
+	if q.listReceptionTransitionsStmt, err = db.PrepareContext(ctx, listReceptionTransitions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReceptionTransitions: %w", err)
+	}
+	if q.listReceptionsStmt, err = db.PrepareContext(ctx, listReceptions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReceptions: %w", err)
+	}
+	if q.listRolePermissionsStmt, err = db.PrepareContext(ctx, listRolePermissions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRolePermissions: %w", err)
+	}
+	if q.listRolesStmt, err = db.PrepareContext(ctx, listRoles); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRoles: %w", err)
+	}
+	if q.listStaffStmt, err = db.PrepareContext(ctx, listStaff); err != nil {
+		return nil, fmt.Errorf("error preparing query ListStaff: %w", err)
+	}
+	if q.listUsersStmt, err = db.PrepareContext(ctx, listUsers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUsers: %w", err)
+	}
+	if q.lockActivePVZStmt, err = db.PrepareContext(ctx, lockActivePVZ); err != nil {
+		return nil, fmt.Errorf("error preparing query LockActivePVZ: %w", err)
+	}
+	if q.lockEventStreamStmt, err = db.PrepareContext(ctx, lockEventStream); err != nil {
+		return nil, fmt.Errorf("error preparing query LockEventStream: %w", err)
+	}
+	if q.lockPVZStmt, err = db.PrepareContext(ctx, lockPVZ); err != nil {
+		return nil, fmt.Errorf("error preparing query LockPVZ: %w", err)
+	}
+	if q.lockReceptionInProgressStmt, err = db.PrepareContext(ctx, lockReceptionInProgress); err != nil {
+		return nil, fmt.Errorf("error preparing query LockReceptionInProgress: %w", err)
+	}
+	if q.pingStmt, err = db.PrepareContext(ctx, ping); err != nil {
+		return nil, fmt.Errorf("error preparing query Ping: %w", err)
+	}
+	if q.publishEventStmt, err = db.PrepareContext(ctx, publishEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query PublishEvent: %w", err)
+	}
+	if q.publishProductEventsStmt, err = db.PrepareContext(ctx, publishProductEvents); err != nil {
+		return nil, fmt.Errorf("error preparing query PublishProductEvents: %w", err)
+	}
+	if q.releaseIdempotencyKeyStmt, err = db.PrepareContext(ctx, releaseIdempotencyKey); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseIdempotencyKey: %w", err)
+	}
+	if q.revokeAccessTokenStmt, err = db.PrepareContext(ctx, revokeAccessToken); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeAccessToken: %w", err)
+	}
+	if q.revokeRefreshTokenStmt, err = db.PrepareContext(ctx, revokeRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeRefreshToken: %w", err)
+	}
+	if q.revokeRefreshTokenFamilyStmt, err = db.PrepareContext(ctx, revokeRefreshTokenFamily); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeRefreshTokenFamily: %w", err)
+	}
+	if q.setReceptionStatusStmt, err = db.PrepareContext(ctx, setReceptionStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query SetReceptionStatus: %w", err)
+	}
+	if q.unassignStaffStmt, err = db.PrepareContext(ctx, unassignStaff); err != nil {
+		return nil, fmt.Errorf("error preparing query UnassignStaff: %w", err)
+	}
+	if q.updatePVZStmt, err = db.PrepareContext(ctx, updatePVZ); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePVZ: %w", err)
+	}
+	if q.updateUserStmt, err = db.PrepareContext(ctx, updateUser); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUser: %w", err)
+	}
+	if q.updateUserPasswordHashStmt, err = db.PrepareContext(ctx, updateUserPasswordHash); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserPasswordHash: %w", err)
+	}
+	if q.upsertCityStmt, err = db.PrepareContext(ctx, upsertCity); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertCity: %w", err)
+	}
+	if q.upsertProductTypeStmt, err = db.PrepareContext(ctx, upsertProductType); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertProductType: %w", err)
+	}
+	if q.upsertRoleStmt, err = db.PrepareContext(ctx, upsertRole); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertRole: %w", err)
+	}
 	return &q, nil
 }
 
@@ -61,11 +226,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addProductStmt: %w", cerr)
 		}
 	}
+	if q.addProductsStmt != nil {
+		if cerr := q.addProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addProductsStmt: %w", cerr)
+		}
+	}
+	if q.addReceptionTransitionStmt != nil {
+		if cerr := q.addReceptionTransitionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addReceptionTransitionStmt: %w", cerr)
+		}
+	}
+	if q.addRolePermissionStmt != nil {
+		if cerr := q.addRolePermissionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addRolePermissionStmt: %w", cerr)
+		}
+	}
+	if q.assignStaffStmt != nil {
+		if cerr := q.assignStaffStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing assignStaffStmt: %w", cerr)
+		}
+	}
+	if q.claimIdempotencyKeyStmt != nil {
+		if cerr := q.claimIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimIdempotencyKeyStmt: %w", cerr)
+		}
+	}
 	if q.closeReceptionStmt != nil {
 		if cerr := q.closeReceptionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing closeReceptionStmt: %w", cerr)
 		}
 	}
+	if q.completeIdempotencyKeyStmt != nil {
+		if cerr := q.completeIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing completeIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.countOpenReceptionsByCityStmt != nil {
+		if cerr := q.countOpenReceptionsByCityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countOpenReceptionsByCityStmt: %w", cerr)
+		}
+	}
 	if q.createOrGetReceptionStmt != nil {
 		if cerr := q.createOrGetReceptionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createOrGetReceptionStmt: %w", cerr)
@@ -76,24 +276,99 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createPVZStmt: %w", cerr)
 		}
 	}
+	if q.createRefreshTokenStmt != nil {
+		if cerr := q.createRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRefreshTokenStmt: %w", cerr)
+		}
+	}
 	if q.createUserStmt != nil {
 		if cerr := q.createUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
 		}
 	}
+	if q.deactivatePVZStmt != nil {
+		if cerr := q.deactivatePVZStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deactivatePVZStmt: %w", cerr)
+		}
+	}
+	if q.deleteEventsBeforeStmt != nil {
+		if cerr := q.deleteEventsBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEventsBeforeStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredIdempotencyKeysStmt != nil {
+		if cerr := q.deleteExpiredIdempotencyKeysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredIdempotencyKeysStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredRevokedTokensStmt != nil {
+		if cerr := q.deleteExpiredRevokedTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredRevokedTokensStmt: %w", cerr)
+		}
+	}
 	if q.deleteLastProductStmt != nil {
 		if cerr := q.deleteLastProductStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteLastProductStmt: %w", cerr)
 		}
 	}
+	if q.deleteRolePermissionsStmt != nil {
+		if cerr := q.deleteRolePermissionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRolePermissionsStmt: %w", cerr)
+		}
+	}
+	if q.getCurrentReceptionStmt != nil {
+		if cerr := q.getCurrentReceptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCurrentReceptionStmt: %w", cerr)
+		}
+	}
+	if q.getIdempotencyKeyStmt != nil {
+		if cerr := q.getIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.getOrCreateUserStmt != nil {
+		if cerr := q.getOrCreateUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOrCreateUserStmt: %w", cerr)
+		}
+	}
+	if q.getPVZCityStmt != nil {
+		if cerr := q.getPVZCityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPVZCityStmt: %w", cerr)
+		}
+	}
+	if q.getPVZWithReceptionsStmt != nil {
+		if cerr := q.getPVZWithReceptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPVZWithReceptionsStmt: %w", cerr)
+		}
+	}
 	if q.getPVZsWithReceptionsStmt != nil {
 		if cerr := q.getPVZsWithReceptionsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getPVZsWithReceptionsStmt: %w", cerr)
 		}
 	}
-	if q.getUserByCredentialsStmt != nil {
-		if cerr := q.getUserByCredentialsStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getUserByCredentialsStmt: %w", cerr)
+	if q.getReceptionStmt != nil {
+		if cerr := q.getReceptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReceptionStmt: %w", cerr)
+		}
+	}
+	if q.getReceptionForUpdateStmt != nil {
+		if cerr := q.getReceptionForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReceptionForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getRefreshTokenForUpdateStmt != nil {
+		if cerr := q.getRefreshTokenForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRefreshTokenForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getUserByEmailStmt != nil {
+		if cerr := q.getUserByEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserByEmailStmt: %w", cerr)
+		}
+	}
+	if q.getUserByIDStmt != nil {
+		if cerr := q.getUserByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserByIDStmt: %w", cerr)
 		}
 	}
 	if q.hasOpenReceptionsStmt != nil {
@@ -101,6 +376,171 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing hasOpenReceptionsStmt: %w", cerr)
 		}
 	}
+	if q.importPVZStmt != nil {
+		if cerr := q.importPVZStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing importPVZStmt: %w", cerr)
+		}
+	}
+	if q.isAccessTokenRevokedStmt != nil {
+		if cerr := q.isAccessTokenRevokedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing isAccessTokenRevokedStmt: %w", cerr)
+		}
+	}
+	if q.isStaffAssignedStmt != nil {
+		if cerr := q.isStaffAssignedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing isStaffAssignedStmt: %w", cerr)
+		}
+	}
+	if q.listCitiesStmt != nil {
+		if cerr := q.listCitiesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCitiesStmt: %w", cerr)
+		}
+	}
+	if q.listEventsSinceStmt != nil {
+		if cerr := q.listEventsSinceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listEventsSinceStmt: %w", cerr)
+		}
+	}
+	if q.listLiveBarcodesStmt != nil {
+		if cerr := q.listLiveBarcodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listLiveBarcodesStmt: %w", cerr)
+		}
+	}
+	if q.listProductTypesStmt != nil {
+		if cerr := q.listProductTypesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductTypesStmt: %w", cerr)
+		}
+	}
+	if q.listReceptionProductsStmt != nil {
+		if cerr := q.listReceptionProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReceptionProductsStmt: %w", cerr)
+		}
+	}
+	if q.listReceptionTransitionsStmt != nil {
+		if cerr := q.listReceptionTransitionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReceptionTransitionsStmt: %w", cerr)
+		}
+	}
+	if q.listReceptionsStmt != nil {
+		if cerr := q.listReceptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReceptionsStmt: %w", cerr)
+		}
+	}
+	if q.listRolePermissionsStmt != nil {
+		if cerr := q.listRolePermissionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRolePermissionsStmt: %w", cerr)
+		}
+	}
+	if q.listRolesStmt != nil {
+		if cerr := q.listRolesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRolesStmt: %w", cerr)
+		}
+	}
+	if q.listStaffStmt != nil {
+		if cerr := q.listStaffStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listStaffStmt: %w", cerr)
+		}
+	}
+	if q.listUsersStmt != nil {
+		if cerr := q.listUsersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUsersStmt: %w", cerr)
+		}
+	}
+	if q.lockActivePVZStmt != nil {
+		if cerr := q.lockActivePVZStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockActivePVZStmt: %w", cerr)
+		}
+	}
+	if q.lockEventStreamStmt != nil {
+		if cerr := q.lockEventStreamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockEventStreamStmt: %w", cerr)
+		}
+	}
+	if q.lockPVZStmt != nil {
+		if cerr := q.lockPVZStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockPVZStmt: %w", cerr)
+		}
+	}
+	if q.lockReceptionInProgressStmt != nil {
+		if cerr := q.lockReceptionInProgressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockReceptionInProgressStmt: %w", cerr)
+		}
+	}
+	if q.pingStmt != nil {
+		if cerr := q.pingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing pingStmt: %w", cerr)
+		}
+	}
+	if q.publishEventStmt != nil {
+		if cerr := q.publishEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing publishEventStmt: %w", cerr)
+		}
+	}
+	if q.publishProductEventsStmt != nil {
+		if cerr := q.publishProductEventsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing publishProductEventsStmt: %w", cerr)
+		}
+	}
+	if q.releaseIdempotencyKeyStmt != nil {
+		if cerr := q.releaseIdempotencyKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseIdempotencyKeyStmt: %w", cerr)
+		}
+	}
+	if q.revokeAccessTokenStmt != nil {
+		if cerr := q.revokeAccessTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeAccessTokenStmt: %w", cerr)
+		}
+	}
+	if q.revokeRefreshTokenStmt != nil {
+		if cerr := q.revokeRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeRefreshTokenStmt: %w", cerr)
+		}
+	}
+	if q.revokeRefreshTokenFamilyStmt != nil {
+		if cerr := q.revokeRefreshTokenFamilyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeRefreshTokenFamilyStmt: %w", cerr)
+		}
+	}
+	if q.setReceptionStatusStmt != nil {
+		if cerr := q.setReceptionStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setReceptionStatusStmt: %w", cerr)
+		}
+	}
+	if q.unassignStaffStmt != nil {
+		if cerr := q.unassignStaffStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unassignStaffStmt: %w", cerr)
+		}
+	}
+	if q.updatePVZStmt != nil {
+		if cerr := q.updatePVZStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePVZStmt: %w", cerr)
+		}
+	}
+	if q.updateUserStmt != nil {
+		if cerr := q.updateUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserStmt: %w", cerr)
+		}
+	}
+	if q.updateUserPasswordHashStmt != nil {
+		if cerr := q.updateUserPasswordHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserPasswordHashStmt: %w", cerr)
+		}
+	}
+	if q.upsertCityStmt != nil {
+		if cerr := q.upsertCityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertCityStmt: %w", cerr)
+		}
+	}
+	if q.upsertProductTypeStmt != nil {
+		if cerr := q.upsertProductTypeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertProductTypeStmt: %w", cerr)
+		}
+	}
+	if q.upsertRoleStmt != nil {
+		if cerr := q.upsertRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertRoleStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -138,31 +578,141 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                        DBTX
-	tx                        *sql.Tx
-	addProductStmt            *sql.Stmt
-	closeReceptionStmt        *sql.Stmt
-	createOrGetReceptionStmt  *sql.Stmt
-	createPVZStmt             *sql.Stmt
-	createUserStmt            *sql.Stmt
-	deleteLastProductStmt     *sql.Stmt
-	getPVZsWithReceptionsStmt *sql.Stmt
-	getUserByCredentialsStmt  *sql.Stmt
-	hasOpenReceptionsStmt     *sql.Stmt
+	db                               DBTX
+	tx                               *sql.Tx
+	addProductStmt                   *sql.Stmt
+	addProductsStmt                  *sql.Stmt
+	addReceptionTransitionStmt       *sql.Stmt
+	addRolePermissionStmt            *sql.Stmt
+	assignStaffStmt                  *sql.Stmt
+	claimIdempotencyKeyStmt          *sql.Stmt
+	closeReceptionStmt               *sql.Stmt
+	completeIdempotencyKeyStmt       *sql.Stmt
+	countOpenReceptionsByCityStmt    *sql.Stmt
+	createOrGetReceptionStmt         *sql.Stmt
+	createPVZStmt                    *sql.Stmt
+	createRefreshTokenStmt           *sql.Stmt
+	createUserStmt                   *sql.Stmt
+	deactivatePVZStmt                *sql.Stmt
+	deleteEventsBeforeStmt           *sql.Stmt
+	deleteExpiredIdempotencyKeysStmt *sql.Stmt
+	deleteExpiredRevokedTokensStmt   *sql.Stmt
+	deleteLastProductStmt            *sql.Stmt
+	deleteRolePermissionsStmt        *sql.Stmt
+	getCurrentReceptionStmt          *sql.Stmt
+	getIdempotencyKeyStmt            *sql.Stmt
+	getOrCreateUserStmt              *sql.Stmt
+	getPVZCityStmt                   *sql.Stmt
+	getPVZWithReceptionsStmt         *sql.Stmt
+	getPVZsWithReceptionsStmt        *sql.Stmt
+	getReceptionStmt                 *sql.Stmt
+	getReceptionForUpdateStmt        *sql.Stmt
+	getRefreshTokenForUpdateStmt     *sql.Stmt
+	getUserByEmailStmt               *sql.Stmt
+	getUserByIDStmt                  *sql.Stmt
+	hasOpenReceptionsStmt            *sql.Stmt
+	importPVZStmt                    *sql.Stmt
+	isAccessTokenRevokedStmt         *sql.Stmt
+	isStaffAssignedStmt              *sql.Stmt
+	listCitiesStmt                   *sql.Stmt
+	listEventsSinceStmt              *sql.Stmt
+	listLiveBarcodesStmt             *sql.Stmt
+	listProductTypesStmt             *sql.Stmt
+	listReceptionProductsStmt        *sql.Stmt
+	listReceptionTransitionsStmt     *sql.Stmt
+	listReceptionsStmt               *sql.Stmt
+	listRolePermissionsStmt          *sql.Stmt
+	listRolesStmt                    *sql.Stmt
+	listStaffStmt                    *sql.Stmt
+	listUsersStmt                    *sql.Stmt
+	lockActivePVZStmt                *sql.Stmt
+	lockEventStreamStmt              *sql.Stmt
+	lockPVZStmt                      *sql.Stmt
+	lockReceptionInProgressStmt      *sql.Stmt
+	pingStmt                         *sql.Stmt
+	publishEventStmt                 *sql.Stmt
+	publishProductEventsStmt         *sql.Stmt
+	releaseIdempotencyKeyStmt        *sql.Stmt
+	revokeAccessTokenStmt            *sql.Stmt
+	revokeRefreshTokenStmt           *sql.Stmt
+	revokeRefreshTokenFamilyStmt     *sql.Stmt
+	setReceptionStatusStmt           *sql.Stmt
+	unassignStaffStmt                *sql.Stmt
+	updatePVZStmt                    *sql.Stmt
+	updateUserStmt                   *sql.Stmt
+	updateUserPasswordHashStmt       *sql.Stmt
+	upsertCityStmt                   *sql.Stmt
+	upsertProductTypeStmt            *sql.Stmt
+	upsertRoleStmt                   *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                        tx,
-		tx:                        tx,
-		addProductStmt:            q.addProductStmt,
-		closeReceptionStmt:        q.closeReceptionStmt,
-		createOrGetReceptionStmt:  q.createOrGetReceptionStmt,
-		createPVZStmt:             q.createPVZStmt,
-		createUserStmt:            q.createUserStmt,
-		deleteLastProductStmt:     q.deleteLastProductStmt,
-		getPVZsWithReceptionsStmt: q.getPVZsWithReceptionsStmt,
-		getUserByCredentialsStmt:  q.getUserByCredentialsStmt,
-		hasOpenReceptionsStmt:     q.hasOpenReceptionsStmt,
+		db:                               tx,
+		tx:                               tx,
+		addProductStmt:                   q.addProductStmt,
+		addProductsStmt:                  q.addProductsStmt,
+		addReceptionTransitionStmt:       q.addReceptionTransitionStmt,
+		addRolePermissionStmt:            q.addRolePermissionStmt,
+		assignStaffStmt:                  q.assignStaffStmt,
+		claimIdempotencyKeyStmt:          q.claimIdempotencyKeyStmt,
+		closeReceptionStmt:               q.closeReceptionStmt,
+		completeIdempotencyKeyStmt:       q.completeIdempotencyKeyStmt,
+		countOpenReceptionsByCityStmt:    q.countOpenReceptionsByCityStmt,
+		createOrGetReceptionStmt:         q.createOrGetReceptionStmt,
+		createPVZStmt:                    q.createPVZStmt,
+		createRefreshTokenStmt:           q.createRefreshTokenStmt,
+		createUserStmt:                   q.createUserStmt,
+		deactivatePVZStmt:                q.deactivatePVZStmt,
+		deleteEventsBeforeStmt:           q.deleteEventsBeforeStmt,
+		deleteExpiredIdempotencyKeysStmt: q.deleteExpiredIdempotencyKeysStmt,
+		deleteExpiredRevokedTokensStmt:   q.deleteExpiredRevokedTokensStmt,
+		deleteLastProductStmt:            q.deleteLastProductStmt,
+		deleteRolePermissionsStmt:        q.deleteRolePermissionsStmt,
+		getCurrentReceptionStmt:          q.getCurrentReceptionStmt,
+		getIdempotencyKeyStmt:            q.getIdempotencyKeyStmt,
+		getOrCreateUserStmt:              q.getOrCreateUserStmt,
+		getPVZCityStmt:                   q.getPVZCityStmt,
+		getPVZWithReceptionsStmt:         q.getPVZWithReceptionsStmt,
+		getPVZsWithReceptionsStmt:        q.getPVZsWithReceptionsStmt,
+		getReceptionStmt:                 q.getReceptionStmt,
+		getReceptionForUpdateStmt:        q.getReceptionForUpdateStmt,
+		getRefreshTokenForUpdateStmt:     q.getRefreshTokenForUpdateStmt,
+		getUserByEmailStmt:               q.getUserByEmailStmt,
+		getUserByIDStmt:                  q.getUserByIDStmt,
+		hasOpenReceptionsStmt:            q.hasOpenReceptionsStmt,
+		importPVZStmt:                    q.importPVZStmt,
+		isAccessTokenRevokedStmt:         q.isAccessTokenRevokedStmt,
+		isStaffAssignedStmt:              q.isStaffAssignedStmt,
+		listCitiesStmt:                   q.listCitiesStmt,
+		listEventsSinceStmt:              q.listEventsSinceStmt,
+		listLiveBarcodesStmt:             q.listLiveBarcodesStmt,
+		listProductTypesStmt:             q.listProductTypesStmt,
+		listReceptionProductsStmt:        q.listReceptionProductsStmt,
+		listReceptionTransitionsStmt:     q.listReceptionTransitionsStmt,
+		listReceptionsStmt:               q.listReceptionsStmt,
+		listRolePermissionsStmt:          q.listRolePermissionsStmt,
+		listRolesStmt:                    q.listRolesStmt,
+		listStaffStmt:                    q.listStaffStmt,
+		listUsersStmt:                    q.listUsersStmt,
+		lockActivePVZStmt:                q.lockActivePVZStmt,
+		lockEventStreamStmt:              q.lockEventStreamStmt,
+		lockPVZStmt:                      q.lockPVZStmt,
+		lockReceptionInProgressStmt:      q.lockReceptionInProgressStmt,
+		pingStmt:                         q.pingStmt,
+		publishEventStmt:                 q.publishEventStmt,
+		publishProductEventsStmt:         q.publishProductEventsStmt,
+		releaseIdempotencyKeyStmt:        q.releaseIdempotencyKeyStmt,
+		revokeAccessTokenStmt:            q.revokeAccessTokenStmt,
+		revokeRefreshTokenStmt:           q.revokeRefreshTokenStmt,
+		revokeRefreshTokenFamilyStmt:     q.revokeRefreshTokenFamilyStmt,
+		setReceptionStatusStmt:           q.setReceptionStatusStmt,
+		unassignStaffStmt:                q.unassignStaffStmt,
+		updatePVZStmt:                    q.updatePVZStmt,
+		updateUserStmt:                   q.updateUserStmt,
+		updateUserPasswordHashStmt:       q.updateUserPasswordHashStmt,
+		upsertCityStmt:                   q.upsertCityStmt,
+		upsertProductTypeStmt:            q.upsertProductTypeStmt,
+		upsertRoleStmt:                   q.upsertRoleStmt,
 	}
 }
@@ -0,0 +1,169 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: events.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const deleteEventsBefore = `-- name: DeleteEventsBefore :execrows
+DELETE FROM pvz_events WHERE created_at < $1
+`
+
+func (q *Queries) DeleteEventsBefore(ctx context.Context, createdAt time.Time) (int64, error) {
+	result, err := q.exec(ctx, q.deleteEventsBeforeStmt, deleteEventsBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listEventsSince = `-- name: ListEventsSince :many
+SELECT id, type, pvz_id, city, reception_id, product_id, product_type, actor_id, created_at
+FROM pvz_events
+WHERE id > $1
+  AND ($2::uuid IS NULL OR pvz_id = $2)
+  AND ($3::text IS NULL OR city = $3)
+ORDER BY id
+LIMIT $4
+`
+
+type ListEventsSinceParams struct {
+	AfterID  int64          `db:"after_id" json:"after_id"`
+	PvzID    uuid.NullUUID  `db:"pvz_id" json:"pvz_id"`
+	City     sql.NullString `db:"city" json:"city"`
+	RowLimit int32          `db:"row_limit" json:"row_limit"`
+}
+
+// Events after the given id for one PVZ or one city, oldest first.
+func (q *Queries) ListEventsSince(ctx context.Context, arg ListEventsSinceParams) ([]PvzEvent, error) {
+	rows, err := q.query(ctx, q.listEventsSinceStmt, listEventsSince,
+		arg.AfterID,
+		arg.PvzID,
+		arg.City,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PvzEvent
+	for rows.Next() {
+		var i PvzEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.PvzID,
+			&i.City,
+			&i.ReceptionID,
+			&i.ProductID,
+			&i.ProductType,
+			&i.ActorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockEventStream = `-- name: LockEventStream :exec
+SELECT pg_advisory_xact_lock(7305210418)
+`
+
+// Serialises the transactions publishing events until they commit, so event
+// ids are handed out in commit order and a client resuming after an id never
+// misses an event committed later with a lower one.
+func (q *Queries) LockEventStream(ctx context.Context) error {
+	_, err := q.exec(ctx, q.lockEventStreamStmt, lockEventStream)
+	return err
+}
+
+const publishEvent = `-- name: PublishEvent :exec
+WITH event AS (
+    INSERT INTO pvz_events (type, pvz_id, city, reception_id, product_id, product_type, actor_id)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)
+    RETURNING id, type, pvz_id, city, reception_id, product_id, product_type, actor_id, created_at
+)
+SELECT pg_notify('pvz_events', json_build_object(
+    'id', id, 'type', type, 'pvzId', pvz_id, 'city', city, 'receptionId', reception_id,
+    'productId', product_id, 'productType', product_type, 'actorId', actor_id, 'createdAt', created_at
+)::text)
+FROM event
+`
+
+type PublishEventParams struct {
+	Type        string         `db:"type" json:"type"`
+	PvzID       uuid.UUID      `db:"pvz_id" json:"pvz_id"`
+	City        string         `db:"city" json:"city"`
+	ReceptionID uuid.UUID      `db:"reception_id" json:"reception_id"`
+	ProductID   uuid.NullUUID  `db:"product_id" json:"product_id"`
+	ProductType sql.NullString `db:"product_type" json:"product_type"`
+	ActorID     uuid.NullUUID  `db:"actor_id" json:"actor_id"`
+}
+
+// Stores the event and NOTIFYs it; listeners only hear it if the
+// surrounding transaction commits.
+func (q *Queries) PublishEvent(ctx context.Context, arg PublishEventParams) error {
+	_, err := q.exec(ctx, q.publishEventStmt, publishEvent,
+		arg.Type,
+		arg.PvzID,
+		arg.City,
+		arg.ReceptionID,
+		arg.ProductID,
+		arg.ProductType,
+		arg.ActorID,
+	)
+	return err
+}
+
+const publishProductEvents = `-- name: PublishProductEvents :exec
+WITH events AS (
+    INSERT INTO pvz_events (type, pvz_id, city, reception_id, product_id, product_type, actor_id)
+    SELECT $1, $2::uuid, $3, p.reception_id, p.id, p.type, $4::uuid
+    FROM products p
+    WHERE p.id = ANY($5::uuid[])
+    ORDER BY p.sequence
+    RETURNING id, type, pvz_id, city, reception_id, product_id, product_type, actor_id, created_at
+)
+SELECT pg_notify('pvz_events', json_build_object(
+    'id', id, 'type', type, 'pvzId', pvz_id, 'city', city, 'receptionId', reception_id,
+    'productId', product_id, 'productType', product_type, 'actorId', actor_id, 'createdAt', created_at
+)::text)
+FROM (SELECT id, type, pvz_id, city, reception_id, product_id, product_type, actor_id, created_at FROM events ORDER BY id) e
+`
+
+type PublishProductEventsParams struct {
+	Type       string      `db:"type" json:"type"`
+	PvzID      uuid.UUID   `db:"pvz_id" json:"pvz_id"`
+	City       string      `db:"city" json:"city"`
+	ActorID    uuid.UUID   `db:"actor_id" json:"actor_id"`
+	ProductIds []uuid.UUID `db:"product_ids" json:"product_ids"`
+}
+
+// PublishEvent for every product of a batch, in scan order.
+func (q *Queries) PublishProductEvents(ctx context.Context, arg PublishProductEventsParams) error {
+	_, err := q.exec(ctx, q.publishProductEventsStmt, publishProductEvents,
+		arg.Type,
+		arg.PvzID,
+		arg.City,
+		arg.ActorID,
+		pq.Array(arg.ProductIds),
+	)
+	return err
+}
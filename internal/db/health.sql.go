@@ -0,0 +1,23 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: health.sql
+
+package db
+
+import (
+	"context"
+)
+
+const ping = `-- name: Ping :one
+SELECT 1::int AS ok
+`
+
+// Goes through a prepared statement, so /readyz also notices statements
+// invalidated by a schema change.
+func (q *Queries) Ping(ctx context.Context) (int32, error) {
+	row := q.queryRow(ctx, q.pingStmt, ping)
+	var ok int32
+	err := row.Scan(&ok)
+	return ok, err
+}
@@ -0,0 +1,132 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: idempotency.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const claimIdempotencyKey = `-- name: ClaimIdempotencyKey :one
+INSERT INTO idempotency_keys (user_id, key, request_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    content_type = NULL,
+    response_body = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW()
+   OR (idempotency_keys.status_code IS NULL AND idempotency_keys.created_at < $5)
+RETURNING created_at
+`
+
+type ClaimIdempotencyKeyParams struct {
+	UserID      uuid.UUID `db:"user_id" json:"user_id"`
+	Key         string    `db:"key" json:"key"`
+	RequestHash []byte    `db:"request_hash" json:"request_hash"`
+	ExpiresAt   time.Time `db:"expires_at" json:"expires_at"`
+	StaleBefore time.Time `db:"stale_before" json:"stale_before"`
+}
+
+// Inserts the key, or takes over a row that has expired or whose request
+// never finished; returns no rows while the key is held by a live entry.
+func (q *Queries) ClaimIdempotencyKey(ctx context.Context, arg ClaimIdempotencyKeyParams) (time.Time, error) {
+	row := q.queryRow(ctx, q.claimIdempotencyKeyStmt, claimIdempotencyKey,
+		arg.UserID,
+		arg.Key,
+		arg.RequestHash,
+		arg.ExpiresAt,
+		arg.StaleBefore,
+	)
+	var created_at time.Time
+	err := row.Scan(&created_at)
+	return created_at, err
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status_code = $3, content_type = $4, response_body = $5
+WHERE user_id = $1 AND key = $2
+`
+
+type CompleteIdempotencyKeyParams struct {
+	UserID       uuid.UUID      `db:"user_id" json:"user_id"`
+	Key          string         `db:"key" json:"key"`
+	StatusCode   sql.NullInt32  `db:"status_code" json:"status_code"`
+	ContentType  sql.NullString `db:"content_type" json:"content_type"`
+	ResponseBody []byte         `db:"response_body" json:"response_body"`
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error {
+	_, err := q.exec(ctx, q.completeIdempotencyKeyStmt, completeIdempotencyKey,
+		arg.UserID,
+		arg.Key,
+		arg.StatusCode,
+		arg.ContentType,
+		arg.ResponseBody,
+	)
+	return err
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys
+WHERE user_id = $1 AND expires_at < NOW()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteExpiredIdempotencyKeysStmt, deleteExpiredIdempotencyKeys, userID)
+	return err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT request_hash, status_code, content_type, response_body
+FROM idempotency_keys
+WHERE user_id = $1 AND key = $2
+`
+
+type GetIdempotencyKeyParams struct {
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+	Key    string    `db:"key" json:"key"`
+}
+
+type GetIdempotencyKeyRow struct {
+	RequestHash  []byte         `db:"request_hash" json:"request_hash"`
+	StatusCode   sql.NullInt32  `db:"status_code" json:"status_code"`
+	ContentType  sql.NullString `db:"content_type" json:"content_type"`
+	ResponseBody []byte         `db:"response_body" json:"response_body"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (GetIdempotencyKeyRow, error) {
+	row := q.queryRow(ctx, q.getIdempotencyKeyStmt, getIdempotencyKey, arg.UserID, arg.Key)
+	var i GetIdempotencyKeyRow
+	err := row.Scan(
+		&i.RequestHash,
+		&i.StatusCode,
+		&i.ContentType,
+		&i.ResponseBody,
+	)
+	return i, err
+}
+
+const releaseIdempotencyKey = `-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE user_id = $1 AND key = $2 AND status_code IS NULL
+`
+
+type ReleaseIdempotencyKeyParams struct {
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+	Key    string    `db:"key" json:"key"`
+}
+
+func (q *Queries) ReleaseIdempotencyKey(ctx context.Context, arg ReleaseIdempotencyKeyParams) error {
+	_, err := q.exec(ctx, q.releaseIdempotencyKeyStmt, releaseIdempotencyKey, arg.UserID, arg.Key)
+	return err
+}
@@ -6,41 +6,138 @@ package db
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+type City struct {
+	Name      string       `db:"name" json:"name"`
+	Active    bool         `db:"active" json:"active"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	UserID       uuid.UUID      `db:"user_id" json:"user_id"`
+	Key          string         `db:"key" json:"key"`
+	RequestHash  []byte         `db:"request_hash" json:"request_hash"`
+	StatusCode   sql.NullInt32  `db:"status_code" json:"status_code"`
+	ContentType  sql.NullString `db:"content_type" json:"content_type"`
+	ResponseBody []byte         `db:"response_body" json:"response_body"`
+	CreatedAt    time.Time      `db:"created_at" json:"created_at"`
+	ExpiresAt    time.Time      `db:"expires_at" json:"expires_at"`
+}
+
 type Product struct {
-	ID          uuid.UUID    `db:"id" json:"id"`
-	DateTime    sql.NullTime `db:"date_time" json:"date_time"`
-	Type        string       `db:"type" json:"type"`
-	ReceptionID uuid.UUID    `db:"reception_id" json:"reception_id"`
-	Sequence    int64        `db:"sequence" json:"sequence"`
-	CreatedAt   sql.NullTime `db:"created_at" json:"created_at"`
+	ID          uuid.UUID      `db:"id" json:"id"`
+	DateTime    sql.NullTime   `db:"date_time" json:"date_time"`
+	Type        string         `db:"type" json:"type"`
+	ReceptionID uuid.UUID      `db:"reception_id" json:"reception_id"`
+	Sequence    int64          `db:"sequence" json:"sequence"`
+	CreatedAt   sql.NullTime   `db:"created_at" json:"created_at"`
+	CreatedBy   uuid.NullUUID  `db:"created_by" json:"created_by"`
+	DeletedAt   sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	DeletedBy   uuid.NullUUID  `db:"deleted_by" json:"deleted_by"`
+	Barcode     sql.NullString `db:"barcode" json:"barcode"`
+	Sku         sql.NullString `db:"sku" json:"sku"`
+	WeightGrams sql.NullInt32  `db:"weight_grams" json:"weight_grams"`
+	LengthMm    sql.NullInt32  `db:"length_mm" json:"length_mm"`
+	WidthMm     sql.NullInt32  `db:"width_mm" json:"width_mm"`
+	HeightMm    sql.NullInt32  `db:"height_mm" json:"height_mm"`
+}
+
+type ProductType struct {
+	Name      string       `db:"name" json:"name"`
+	Active    bool         `db:"active" json:"active"`
+	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
 }
 
 type Pvz struct {
-	ID               uuid.UUID    `db:"id" json:"id"`
-	RegistrationDate sql.NullTime `db:"registration_date" json:"registration_date"`
-	City             string       `db:"city" json:"city"`
-	CreatedAt        sql.NullTime `db:"created_at" json:"created_at"`
-	UpdatedAt        sql.NullTime `db:"updated_at" json:"updated_at"`
+	ID               uuid.UUID     `db:"id" json:"id"`
+	RegistrationDate sql.NullTime  `db:"registration_date" json:"registration_date"`
+	City             string        `db:"city" json:"city"`
+	CreatedAt        sql.NullTime  `db:"created_at" json:"created_at"`
+	UpdatedAt        sql.NullTime  `db:"updated_at" json:"updated_at"`
+	DeactivatedAt    sql.NullTime  `db:"deactivated_at" json:"deactivated_at"`
+	DeactivatedBy    uuid.NullUUID `db:"deactivated_by" json:"deactivated_by"`
+}
+
+type PvzEvent struct {
+	ID          int64          `db:"id" json:"id"`
+	Type        string         `db:"type" json:"type"`
+	PvzID       uuid.UUID      `db:"pvz_id" json:"pvz_id"`
+	City        string         `db:"city" json:"city"`
+	ReceptionID uuid.UUID      `db:"reception_id" json:"reception_id"`
+	ProductID   uuid.NullUUID  `db:"product_id" json:"product_id"`
+	ProductType sql.NullString `db:"product_type" json:"product_type"`
+	ActorID     uuid.NullUUID  `db:"actor_id" json:"actor_id"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+}
+
+type PvzStaff struct {
+	PvzID      uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	UserID     uuid.UUID     `db:"user_id" json:"user_id"`
+	AssignedBy uuid.NullUUID `db:"assigned_by" json:"assigned_by"`
+	AssignedAt sql.NullTime  `db:"assigned_at" json:"assigned_at"`
 }
 
 type Reception struct {
-	ID        uuid.UUID    `db:"id" json:"id"`
-	DateTime  sql.NullTime `db:"date_time" json:"date_time"`
-	PvzID     uuid.UUID    `db:"pvz_id" json:"pvz_id"`
-	Status    string       `db:"status" json:"status"`
-	CreatedAt sql.NullTime `db:"created_at" json:"created_at"`
-	UpdatedAt sql.NullTime `db:"updated_at" json:"updated_at"`
+	ID        uuid.UUID     `db:"id" json:"id"`
+	DateTime  sql.NullTime  `db:"date_time" json:"date_time"`
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	Status    string        `db:"status" json:"status"`
+	CreatedAt sql.NullTime  `db:"created_at" json:"created_at"`
+	UpdatedAt sql.NullTime  `db:"updated_at" json:"updated_at"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	ClosedBy  uuid.NullUUID `db:"closed_by" json:"closed_by"`
+	ClosedAt  sql.NullTime  `db:"closed_at" json:"closed_at"`
+}
+
+type ReceptionTransition struct {
+	ID          int64          `db:"id" json:"id"`
+	ReceptionID uuid.UUID      `db:"reception_id" json:"reception_id"`
+	FromStatus  string         `db:"from_status" json:"from_status"`
+	ToStatus    string         `db:"to_status" json:"to_status"`
+	Reason      sql.NullString `db:"reason" json:"reason"`
+	ActorID     uuid.NullUUID  `db:"actor_id" json:"actor_id"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+}
+
+type RefreshToken struct {
+	ID         uuid.UUID     `db:"id" json:"id"`
+	UserID     uuid.UUID     `db:"user_id" json:"user_id"`
+	FamilyID   uuid.UUID     `db:"family_id" json:"family_id"`
+	TokenHash  string        `db:"token_hash" json:"token_hash"`
+	ExpiresAt  time.Time     `db:"expires_at" json:"expires_at"`
+	RevokedAt  sql.NullTime  `db:"revoked_at" json:"revoked_at"`
+	ReplacedBy uuid.NullUUID `db:"replaced_by" json:"replaced_by"`
+	CreatedAt  sql.NullTime  `db:"created_at" json:"created_at"`
+}
+
+type RevokedToken struct {
+	Jti       uuid.UUID    `db:"jti" json:"jti"`
+	ExpiresAt time.Time    `db:"expires_at" json:"expires_at"`
+	RevokedAt sql.NullTime `db:"revoked_at" json:"revoked_at"`
+}
+
+type Role struct {
+	Name        string       `db:"name" json:"name"`
+	Description string       `db:"description" json:"description"`
+	CreatedAt   sql.NullTime `db:"created_at" json:"created_at"`
+}
+
+type RolePermission struct {
+	Role       string `db:"role" json:"role"`
+	Permission string `db:"permission" json:"permission"`
+	Scope      string `db:"scope" json:"scope"`
 }
 
 type User struct {
-	ID           uuid.UUID    `db:"id" json:"id"`
-	Email        string       `db:"email" json:"email"`
-	PasswordHash string       `db:"password_hash" json:"password_hash"`
-	Role         string       `db:"role" json:"role"`
-	CreatedAt    sql.NullTime `db:"created_at" json:"created_at"`
-	UpdatedAt    sql.NullTime `db:"updated_at" json:"updated_at"`
+	ID           uuid.UUID      `db:"id" json:"id"`
+	Email        string         `db:"email" json:"email"`
+	PasswordHash string         `db:"password_hash" json:"password_hash"`
+	Role         string         `db:"role" json:"role"`
+	CreatedAt    sql.NullTime   `db:"created_at" json:"created_at"`
+	UpdatedAt    sql.NullTime   `db:"updated_at" json:"updated_at"`
+	City         sql.NullString `db:"city" json:"city"`
 }
@@ -10,6 +10,7 @@ import (
 	"database/sql"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const addProduct = `-- name: AddProduct :one
@@ -20,58 +21,314 @@ WITH current_reception AS (
     FOR SHARE
 ),
 product_insert AS (
-    INSERT INTO products (type, reception_id)
-    SELECT $2, id FROM current_reception
-    RETURNING id, date_time, type, reception_id
+    INSERT INTO products (type, created_by, barcode, sku, weight_grams, length_mm, width_mm, height_mm, reception_id)
+    SELECT $2, $3, $4, $5, $6, $7, $8, $9, id FROM current_reception
+    RETURNING id, date_time, type, reception_id, created_by, barcode, sku, weight_grams, length_mm, width_mm, height_mm
 )
-SELECT id, date_time, type, reception_id FROM product_insert
+SELECT id, date_time, type, reception_id, created_by, barcode, sku, weight_grams, length_mm, width_mm, height_mm FROM product_insert
 UNION ALL
-SELECT NULL, NULL, NULL, NULL
+SELECT NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL
 WHERE NOT EXISTS (SELECT 1 FROM product_insert)
 `
 
 type AddProductParams struct {
-	PvzID uuid.UUID `db:"pvz_id" json:"pvz_id"`
-	Type  string    `db:"type" json:"type"`
+	PvzID       uuid.UUID      `db:"pvz_id" json:"pvz_id"`
+	Type        string         `db:"type" json:"type"`
+	CreatedBy   uuid.NullUUID  `db:"created_by" json:"created_by"`
+	Barcode     sql.NullString `db:"barcode" json:"barcode"`
+	Sku         sql.NullString `db:"sku" json:"sku"`
+	WeightGrams sql.NullInt32  `db:"weight_grams" json:"weight_grams"`
+	LengthMm    sql.NullInt32  `db:"length_mm" json:"length_mm"`
+	WidthMm     sql.NullInt32  `db:"width_mm" json:"width_mm"`
+	HeightMm    sql.NullInt32  `db:"height_mm" json:"height_mm"`
 }
 
 type AddProductRow struct {
-	ID          uuid.UUID    `db:"id" json:"id"`
-	DateTime    sql.NullTime `db:"date_time" json:"date_time"`
-	Type        string       `db:"type" json:"type"`
-	ReceptionID uuid.UUID    `db:"reception_id" json:"reception_id"`
+	ID          uuid.UUID      `db:"id" json:"id"`
+	DateTime    sql.NullTime   `db:"date_time" json:"date_time"`
+	Type        string         `db:"type" json:"type"`
+	ReceptionID uuid.UUID      `db:"reception_id" json:"reception_id"`
+	CreatedBy   uuid.NullUUID  `db:"created_by" json:"created_by"`
+	Barcode     sql.NullString `db:"barcode" json:"barcode"`
+	Sku         sql.NullString `db:"sku" json:"sku"`
+	WeightGrams sql.NullInt32  `db:"weight_grams" json:"weight_grams"`
+	LengthMm    sql.NullInt32  `db:"length_mm" json:"length_mm"`
+	WidthMm     sql.NullInt32  `db:"width_mm" json:"width_mm"`
+	HeightMm    sql.NullInt32  `db:"height_mm" json:"height_mm"`
 }
 
 func (q *Queries) AddProduct(ctx context.Context, arg AddProductParams) (AddProductRow, error) {
-	row := q.queryRow(ctx, q.addProductStmt, addProduct, arg.PvzID, arg.Type)
+	row := q.queryRow(ctx, q.addProductStmt, addProduct,
+		arg.PvzID,
+		arg.Type,
+		arg.CreatedBy,
+		arg.Barcode,
+		arg.Sku,
+		arg.WeightGrams,
+		arg.LengthMm,
+		arg.WidthMm,
+		arg.HeightMm,
+	)
 	var i AddProductRow
 	err := row.Scan(
 		&i.ID,
 		&i.DateTime,
 		&i.Type,
 		&i.ReceptionID,
+		&i.CreatedBy,
+		&i.Barcode,
+		&i.Sku,
+		&i.WeightGrams,
+		&i.LengthMm,
+		&i.WidthMm,
+		&i.HeightMm,
 	)
 	return i, err
 }
 
+const addProducts = `-- name: AddProducts :many
+INSERT INTO products (reception_id, created_by, type, barcode, sku, weight_grams, length_mm, width_mm, height_mm)
+SELECT $1::uuid, $2::uuid,
+    ($3::text[])[i],
+    NULLIF(($4::text[])[i], ''),
+    NULLIF(($5::text[])[i], ''),
+    NULLIF(($6::int[])[i], 0),
+    NULLIF(($7::int[])[i], 0),
+    NULLIF(($8::int[])[i], 0),
+    NULLIF(($9::int[])[i], 0)
+FROM generate_subscripts($3::text[], 1) AS i
+ORDER BY i
+RETURNING id, date_time, type, reception_id, created_by, barcode, sku, weight_grams, length_mm, width_mm, height_mm, sequence
+`
+
+type AddProductsParams struct {
+	ReceptionID  uuid.UUID `db:"reception_id" json:"reception_id"`
+	CreatedBy    uuid.UUID `db:"created_by" json:"created_by"`
+	Types        []string  `db:"types" json:"types"`
+	Barcodes     []string  `db:"barcodes" json:"barcodes"`
+	Skus         []string  `db:"skus" json:"skus"`
+	WeightsGrams []int32   `db:"weights_grams" json:"weights_grams"`
+	LengthsMm    []int32   `db:"lengths_mm" json:"lengths_mm"`
+	WidthsMm     []int32   `db:"widths_mm" json:"widths_mm"`
+	HeightsMm    []int32   `db:"heights_mm" json:"heights_mm"`
+}
+
+type AddProductsRow struct {
+	ID          uuid.UUID      `db:"id" json:"id"`
+	DateTime    sql.NullTime   `db:"date_time" json:"date_time"`
+	Type        string         `db:"type" json:"type"`
+	ReceptionID uuid.UUID      `db:"reception_id" json:"reception_id"`
+	CreatedBy   uuid.NullUUID  `db:"created_by" json:"created_by"`
+	Barcode     sql.NullString `db:"barcode" json:"barcode"`
+	Sku         sql.NullString `db:"sku" json:"sku"`
+	WeightGrams sql.NullInt32  `db:"weight_grams" json:"weight_grams"`
+	LengthMm    sql.NullInt32  `db:"length_mm" json:"length_mm"`
+	WidthMm     sql.NullInt32  `db:"width_mm" json:"width_mm"`
+	HeightMm    sql.NullInt32  `db:"height_mm" json:"height_mm"`
+	Sequence    int64          `db:"sequence" json:"sequence"`
+}
+
+// Multi-row insert of a batch, one element of each array per product. Empty
+// strings and zeros stand for NULL, since the arrays cannot hold NULLs;
+// ordering by position keeps the sequence in request order so LIFO deletion
+// undoes the batch item by item.
+func (q *Queries) AddProducts(ctx context.Context, arg AddProductsParams) ([]AddProductsRow, error) {
+	rows, err := q.query(ctx, q.addProductsStmt, addProducts,
+		arg.ReceptionID,
+		arg.CreatedBy,
+		pq.Array(arg.Types),
+		pq.Array(arg.Barcodes),
+		pq.Array(arg.Skus),
+		pq.Array(arg.WeightsGrams),
+		pq.Array(arg.LengthsMm),
+		pq.Array(arg.WidthsMm),
+		pq.Array(arg.HeightsMm),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AddProductsRow
+	for rows.Next() {
+		var i AddProductsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.Type,
+			&i.ReceptionID,
+			&i.CreatedBy,
+			&i.Barcode,
+			&i.Sku,
+			&i.WeightGrams,
+			&i.LengthMm,
+			&i.WidthMm,
+			&i.HeightMm,
+			&i.Sequence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteLastProduct = `-- name: DeleteLastProduct :one
 WITH product_to_delete AS (
     SELECT p.id
     FROM products p
     JOIN receptions r ON p.reception_id = r.id
-    WHERE r.pvz_id = $1 AND r.status = 'in_progress'
+    WHERE r.pvz_id = $1 AND r.status = 'in_progress' AND p.deleted_at IS NULL
     ORDER BY p.sequence DESC
     LIMIT 1
     FOR UPDATE SKIP LOCKED
 )
-DELETE FROM products
+UPDATE products
+SET deleted_at = NOW(), deleted_by = $2
 WHERE id IN (SELECT id FROM product_to_delete)
-RETURNING id
+RETURNING id, type, reception_id
+`
+
+type DeleteLastProductParams struct {
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	DeletedBy uuid.NullUUID `db:"deleted_by" json:"deleted_by"`
+}
+
+type DeleteLastProductRow struct {
+	ID          uuid.UUID `db:"id" json:"id"`
+	Type        string    `db:"type" json:"type"`
+	ReceptionID uuid.UUID `db:"reception_id" json:"reception_id"`
+}
+
+func (q *Queries) DeleteLastProduct(ctx context.Context, arg DeleteLastProductParams) (DeleteLastProductRow, error) {
+	row := q.queryRow(ctx, q.deleteLastProductStmt, deleteLastProduct, arg.PvzID, arg.DeletedBy)
+	var i DeleteLastProductRow
+	err := row.Scan(&i.ID, &i.Type, &i.ReceptionID)
+	return i, err
+}
+
+const listLiveBarcodes = `-- name: ListLiveBarcodes :many
+SELECT barcode::text
+FROM products
+WHERE reception_id = $1 AND deleted_at IS NULL AND barcode = ANY($2::text[])
+`
+
+type ListLiveBarcodesParams struct {
+	ReceptionID uuid.UUID `db:"reception_id" json:"reception_id"`
+	Barcodes    []string  `db:"barcodes" json:"barcodes"`
+}
+
+func (q *Queries) ListLiveBarcodes(ctx context.Context, arg ListLiveBarcodesParams) ([]string, error) {
+	rows, err := q.query(ctx, q.listLiveBarcodesStmt, listLiveBarcodes, arg.ReceptionID, pq.Array(arg.Barcodes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var barcode string
+		if err := rows.Scan(&barcode); err != nil {
+			return nil, err
+		}
+		items = append(items, barcode)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReceptionProducts = `-- name: ListReceptionProducts :many
+SELECT id, date_time, type, reception_id, created_by, deleted_at, deleted_by,
+    barcode, sku, weight_grams, length_mm, width_mm, height_mm
+FROM products
+WHERE reception_id = $1 AND ($2::boolean OR deleted_at IS NULL)
+ORDER BY sequence DESC
 `
 
-func (q *Queries) DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) (uuid.UUID, error) {
-	row := q.queryRow(ctx, q.deleteLastProductStmt, deleteLastProduct, pvzID)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+type ListReceptionProductsParams struct {
+	ReceptionID uuid.UUID `db:"reception_id" json:"reception_id"`
+	Column2     bool      `db:"column_2" json:"column_2"`
+}
+
+type ListReceptionProductsRow struct {
+	ID          uuid.UUID      `db:"id" json:"id"`
+	DateTime    sql.NullTime   `db:"date_time" json:"date_time"`
+	Type        string         `db:"type" json:"type"`
+	ReceptionID uuid.UUID      `db:"reception_id" json:"reception_id"`
+	CreatedBy   uuid.NullUUID  `db:"created_by" json:"created_by"`
+	DeletedAt   sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	DeletedBy   uuid.NullUUID  `db:"deleted_by" json:"deleted_by"`
+	Barcode     sql.NullString `db:"barcode" json:"barcode"`
+	Sku         sql.NullString `db:"sku" json:"sku"`
+	WeightGrams sql.NullInt32  `db:"weight_grams" json:"weight_grams"`
+	LengthMm    sql.NullInt32  `db:"length_mm" json:"length_mm"`
+	WidthMm     sql.NullInt32  `db:"width_mm" json:"width_mm"`
+	HeightMm    sql.NullInt32  `db:"height_mm" json:"height_mm"`
+}
+
+func (q *Queries) ListReceptionProducts(ctx context.Context, arg ListReceptionProductsParams) ([]ListReceptionProductsRow, error) {
+	rows, err := q.query(ctx, q.listReceptionProductsStmt, listReceptionProducts, arg.ReceptionID, arg.Column2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReceptionProductsRow
+	for rows.Next() {
+		var i ListReceptionProductsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.Type,
+			&i.ReceptionID,
+			&i.CreatedBy,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.Barcode,
+			&i.Sku,
+			&i.WeightGrams,
+			&i.LengthMm,
+			&i.WidthMm,
+			&i.HeightMm,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockReceptionInProgress = `-- name: LockReceptionInProgress :one
+SELECT r.pvz_id, p.city
+FROM receptions r
+JOIN pvz p ON p.id = r.pvz_id
+WHERE r.id = $1 AND r.status = 'in_progress'
+FOR SHARE OF r
+`
+
+type LockReceptionInProgressRow struct {
+	PvzID uuid.UUID `db:"pvz_id" json:"pvz_id"`
+	City  string    `db:"city" json:"city"`
+}
+
+// Holds the reception open, like AddProduct's FOR SHARE, while a batch is inserted
+func (q *Queries) LockReceptionInProgress(ctx context.Context, id uuid.UUID) (LockReceptionInProgressRow, error) {
+	row := q.queryRow(ctx, q.lockReceptionInProgressStmt, lockReceptionInProgress, id)
+	var i LockReceptionInProgressRow
+	err := row.Scan(&i.PvzID, &i.City)
+	return i, err
 }
@@ -34,10 +34,115 @@ func (q *Queries) CreatePVZ(ctx context.Context, city string) (CreatePVZRow, err
 	return i, err
 }
 
+const deactivatePVZ = `-- name: DeactivatePVZ :execrows
+UPDATE pvz
+SET deactivated_at = NOW(), deactivated_by = $2, updated_at = NOW()
+WHERE id = $1 AND deactivated_at IS NULL
+`
+
+type DeactivatePVZParams struct {
+	ID            uuid.UUID     `db:"id" json:"id"`
+	DeactivatedBy uuid.NullUUID `db:"deactivated_by" json:"deactivated_by"`
+}
+
+func (q *Queries) DeactivatePVZ(ctx context.Context, arg DeactivatePVZParams) (int64, error) {
+	result, err := q.exec(ctx, q.deactivatePVZStmt, deactivatePVZ, arg.ID, arg.DeactivatedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getPVZCity = `-- name: GetPVZCity :one
+SELECT city FROM pvz
+WHERE id = $1
+`
+
+func (q *Queries) GetPVZCity(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.queryRow(ctx, q.getPVZCityStmt, getPVZCity, id)
+	var city string
+	err := row.Scan(&city)
+	return city, err
+}
+
+const getPVZWithReceptions = `-- name: GetPVZWithReceptions :one
+SELECT
+    p.id AS pvz_id,
+    p.registration_date,
+    p.city,
+    p.deactivated_at,
+    COALESCE(
+        (SELECT json_agg(json_build_object(
+            'reception', json_build_object(
+                'id', r.id,
+                'dateTime', r.date_time,
+                'status', r.status,
+                'pvzId', r.pvz_id,
+                'createdBy', r.created_by,
+                'closedBy', r.closed_by
+            ),
+            'products', (
+                SELECT json_agg(json_build_object(
+                    'id', pr.id,
+                    'dateTime', pr.date_time,
+                    'type', pr.type,
+                    'receptionId', pr.reception_id,
+                    'createdBy', pr.created_by,
+                    'deletedAt', pr.deleted_at,
+                    'deletedBy', pr.deleted_by,
+                    'barcode', pr.barcode,
+                    'sku', pr.sku,
+                    'weightGrams', pr.weight_grams,
+                    'dimensions', CASE WHEN pr.length_mm IS NULL THEN NULL ELSE json_build_object(
+                        'lengthMm', pr.length_mm,
+                        'widthMm', pr.width_mm,
+                        'heightMm', pr.height_mm
+                    ) END
+                ) ORDER BY pr.sequence DESC)
+                FROM products pr
+                WHERE pr.reception_id = r.id
+                    AND ($2::boolean OR pr.deleted_at IS NULL)
+            )
+        ) ORDER BY r.date_time DESC)
+        FROM receptions r
+        WHERE r.pvz_id = p.id),
+        '[]'::json
+    )::text AS receptions_json
+FROM pvz p
+WHERE p.id = $1
+`
+
+type GetPVZWithReceptionsParams struct {
+	ID      uuid.UUID `db:"id" json:"id"`
+	Column2 bool      `db:"column_2" json:"column_2"`
+}
+
+type GetPVZWithReceptionsRow struct {
+	PvzID            uuid.UUID    `db:"pvz_id" json:"pvz_id"`
+	RegistrationDate sql.NullTime `db:"registration_date" json:"registration_date"`
+	City             string       `db:"city" json:"city"`
+	DeactivatedAt    sql.NullTime `db:"deactivated_at" json:"deactivated_at"`
+	ReceptionsJson   string       `db:"receptions_json" json:"receptions_json"`
+}
+
+func (q *Queries) GetPVZWithReceptions(ctx context.Context, arg GetPVZWithReceptionsParams) (GetPVZWithReceptionsRow, error) {
+	row := q.queryRow(ctx, q.getPVZWithReceptionsStmt, getPVZWithReceptions, arg.ID, arg.Column2)
+	var i GetPVZWithReceptionsRow
+	err := row.Scan(
+		&i.PvzID,
+		&i.RegistrationDate,
+		&i.City,
+		&i.DeactivatedAt,
+		&i.ReceptionsJson,
+	)
+	return i, err
+}
+
 const getPVZsWithReceptions = `-- name: GetPVZsWithReceptions :many
 WITH pvz_paginated AS (
     SELECT id, registration_date, city
     FROM pvz
+    WHERE deactivated_at IS NULL AND ($5::text = '' OR city = $5::text)
     ORDER BY registration_date DESC, id
     LIMIT $3 OFFSET (($4 - 1) * $3)
 ),
@@ -47,14 +152,28 @@ reception_data AS (
         r.id AS reception_id,
         r.date_time,
         r.status,
+        r.created_by,
+        r.closed_by,
         (SELECT json_agg(json_build_object(
             'id', p.id,
             'dateTime', p.date_time,
             'type', p.type,
-            'receptionId', p.reception_id
+            'receptionId', p.reception_id,
+            'createdBy', p.created_by,
+            'deletedAt', p.deleted_at,
+            'deletedBy', p.deleted_by,
+            'barcode', p.barcode,
+            'sku', p.sku,
+            'weightGrams', p.weight_grams,
+            'dimensions', CASE WHEN p.length_mm IS NULL THEN NULL ELSE json_build_object(
+                'lengthMm', p.length_mm,
+                'widthMm', p.width_mm,
+                'heightMm', p.height_mm
+            ) END
         ) ORDER BY p.sequence DESC)
         FROM products p
         WHERE p.reception_id = r.id
+            AND ($6::boolean OR p.deleted_at IS NULL)
         ) AS products
     FROM receptions r
     WHERE 
@@ -72,7 +191,9 @@ SELECT
                 'id', rd.reception_id,
                 'dateTime', rd.date_time,
                 'status', rd.status,
-                'pvzId', rd.pvz_id
+                'pvzId', rd.pvz_id,
+                'createdBy', rd.created_by,
+                'closedBy', rd.closed_by
             ),
             'products', rd.products
         )) FROM reception_data rd WHERE rd.pvz_id = p.id),
@@ -86,6 +207,8 @@ type GetPVZsWithReceptionsParams struct {
 	DateTime_2 sql.NullTime `db:"date_time_2" json:"date_time_2"`
 	Limit      int32        `db:"limit" json:"limit"`
 	Column4    interface{}  `db:"column_4" json:"column_4"`
+	Column5    string       `db:"column_5" json:"column_5"`
+	Column6    bool         `db:"column_6" json:"column_6"`
 }
 
 type GetPVZsWithReceptionsRow struct {
@@ -101,6 +224,8 @@ func (q *Queries) GetPVZsWithReceptions(ctx context.Context, arg GetPVZsWithRece
 		arg.DateTime_2,
 		arg.Limit,
 		arg.Column4,
+		arg.Column5,
+		arg.Column6,
 	)
 	if err != nil {
 		return nil, err
@@ -127,3 +252,87 @@ func (q *Queries) GetPVZsWithReceptions(ctx context.Context, arg GetPVZsWithRece
 	}
 	return items, nil
 }
+
+const importPVZ = `-- name: ImportPVZ :one
+INSERT INTO pvz (
+    id, registration_date, city
+) VALUES (
+    COALESCE($1::uuid, uuid_generate_v4()),
+    COALESCE($2::timestamptz, NOW()),
+    $3
+)
+ON CONFLICT (id) DO NOTHING
+RETURNING id, registration_date, city
+`
+
+type ImportPVZParams struct {
+	ID               uuid.NullUUID `db:"id" json:"id"`
+	RegistrationDate sql.NullTime  `db:"registration_date" json:"registration_date"`
+	City             string        `db:"city" json:"city"`
+}
+
+type ImportPVZRow struct {
+	ID               uuid.UUID    `db:"id" json:"id"`
+	RegistrationDate sql.NullTime `db:"registration_date" json:"registration_date"`
+	City             string       `db:"city" json:"city"`
+}
+
+// Keeps the id and registration date of a PVZ moved from another system;
+// a PVZ imported twice is left as is.
+func (q *Queries) ImportPVZ(ctx context.Context, arg ImportPVZParams) (ImportPVZRow, error) {
+	row := q.queryRow(ctx, q.importPVZStmt, importPVZ, arg.ID, arg.RegistrationDate, arg.City)
+	var i ImportPVZRow
+	err := row.Scan(&i.ID, &i.RegistrationDate, &i.City)
+	return i, err
+}
+
+const lockActivePVZ = `-- name: LockActivePVZ :one
+SELECT city FROM pvz
+WHERE id = $1 AND deactivated_at IS NULL
+FOR SHARE
+`
+
+func (q *Queries) LockActivePVZ(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.queryRow(ctx, q.lockActivePVZStmt, lockActivePVZ, id)
+	var city string
+	err := row.Scan(&city)
+	return city, err
+}
+
+const lockPVZ = `-- name: LockPVZ :one
+SELECT deactivated_at FROM pvz
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockPVZ(ctx context.Context, id uuid.UUID) (sql.NullTime, error) {
+	row := q.queryRow(ctx, q.lockPVZStmt, lockPVZ, id)
+	var deactivated_at sql.NullTime
+	err := row.Scan(&deactivated_at)
+	return deactivated_at, err
+}
+
+const updatePVZ = `-- name: UpdatePVZ :one
+UPDATE pvz
+SET city = $2, updated_at = NOW()
+WHERE id = $1 AND deactivated_at IS NULL
+RETURNING id, registration_date, city
+`
+
+type UpdatePVZParams struct {
+	ID   uuid.UUID `db:"id" json:"id"`
+	City string    `db:"city" json:"city"`
+}
+
+type UpdatePVZRow struct {
+	ID               uuid.UUID    `db:"id" json:"id"`
+	RegistrationDate sql.NullTime `db:"registration_date" json:"registration_date"`
+	City             string       `db:"city" json:"city"`
+}
+
+func (q *Queries) UpdatePVZ(ctx context.Context, arg UpdatePVZParams) (UpdatePVZRow, error) {
+	row := q.queryRow(ctx, q.updatePVZStmt, updatePVZ, arg.ID, arg.City)
+	var i UpdatePVZRow
+	err := row.Scan(&i.ID, &i.RegistrationDate, &i.City)
+	return i, err
+}
@@ -6,20 +6,96 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
 	AddProduct(ctx context.Context, arg AddProductParams) (AddProductRow, error)
-	CloseReception(ctx context.Context, pvzID uuid.UUID) (CloseReceptionRow, error)
-	CreateOrGetReception(ctx context.Context, pvzID uuid.UUID) (CreateOrGetReceptionRow, error)
+	// Multi-row insert of a batch, one element of each array per product. Empty
+	// strings and zeros stand for NULL, since the arrays cannot hold NULLs;
+	// ordering by position keeps the sequence in request order so LIFO deletion
+	// undoes the batch item by item.
+	AddProducts(ctx context.Context, arg AddProductsParams) ([]AddProductsRow, error)
+	AddReceptionTransition(ctx context.Context, arg AddReceptionTransitionParams) error
+	AddRolePermission(ctx context.Context, arg AddRolePermissionParams) error
+	AssignStaff(ctx context.Context, arg AssignStaffParams) (PvzStaff, error)
+	// Inserts the key, or takes over a row that has expired or whose request
+	// never finished; returns no rows while the key is held by a live entry.
+	ClaimIdempotencyKey(ctx context.Context, arg ClaimIdempotencyKeyParams) (time.Time, error)
+	CloseReception(ctx context.Context, arg CloseReceptionParams) (CloseReceptionRow, error)
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error
+	CountOpenReceptionsByCity(ctx context.Context) ([]CountOpenReceptionsByCityRow, error)
+	CreateOrGetReception(ctx context.Context, arg CreateOrGetReceptionParams) (CreateOrGetReceptionRow, error)
 	CreatePVZ(ctx context.Context, city string) (CreatePVZRow, error)
+	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (uuid.UUID, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error)
-	DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) (uuid.UUID, error)
+	DeactivatePVZ(ctx context.Context, arg DeactivatePVZParams) (int64, error)
+	DeleteEventsBefore(ctx context.Context, createdAt time.Time) (int64, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context, userID uuid.UUID) error
+	DeleteExpiredRevokedTokens(ctx context.Context) error
+	DeleteLastProduct(ctx context.Context, arg DeleteLastProductParams) (DeleteLastProductRow, error)
+	DeleteRolePermissions(ctx context.Context, role string) error
+	GetCurrentReception(ctx context.Context, pvzID uuid.UUID) (GetCurrentReceptionRow, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (GetIdempotencyKeyRow, error)
+	GetOrCreateUser(ctx context.Context, arg GetOrCreateUserParams) (GetOrCreateUserRow, error)
+	GetPVZCity(ctx context.Context, id uuid.UUID) (string, error)
+	GetPVZWithReceptions(ctx context.Context, arg GetPVZWithReceptionsParams) (GetPVZWithReceptionsRow, error)
 	GetPVZsWithReceptions(ctx context.Context, arg GetPVZsWithReceptionsParams) ([]GetPVZsWithReceptionsRow, error)
-	GetUserByCredentials(ctx context.Context, arg GetUserByCredentialsParams) (string, error)
+	GetReception(ctx context.Context, id uuid.UUID) (GetReceptionRow, error)
+	GetReceptionForUpdate(ctx context.Context, id uuid.UUID) (GetReceptionForUpdateRow, error)
+	GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (GetRefreshTokenForUpdateRow, error)
+	GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (GetUserByIDRow, error)
 	HasOpenReceptions(ctx context.Context, pvzID uuid.UUID) (bool, error)
+	// Keeps the id and registration date of a PVZ moved from another system;
+	// a PVZ imported twice is left as is.
+	ImportPVZ(ctx context.Context, arg ImportPVZParams) (ImportPVZRow, error)
+	IsAccessTokenRevoked(ctx context.Context, jti uuid.UUID) (bool, error)
+	IsStaffAssigned(ctx context.Context, arg IsStaffAssignedParams) (bool, error)
+	ListCities(ctx context.Context) ([]ListCitiesRow, error)
+	// Events after the given id for one PVZ or one city, oldest first.
+	ListEventsSince(ctx context.Context, arg ListEventsSinceParams) ([]PvzEvent, error)
+	ListLiveBarcodes(ctx context.Context, arg ListLiveBarcodesParams) ([]string, error)
+	ListProductTypes(ctx context.Context) ([]ListProductTypesRow, error)
+	ListReceptionProducts(ctx context.Context, arg ListReceptionProductsParams) ([]ListReceptionProductsRow, error)
+	ListReceptionTransitions(ctx context.Context, receptionID uuid.UUID) ([]ReceptionTransition, error)
+	// Keyset pagination: the cursor is the (date_time, id) of the last row of the previous page
+	ListReceptions(ctx context.Context, arg ListReceptionsParams) ([]ListReceptionsRow, error)
+	ListRolePermissions(ctx context.Context) ([]ListRolePermissionsRow, error)
+	ListRoles(ctx context.Context) ([]ListRolesRow, error)
+	ListStaff(ctx context.Context, pvzID uuid.UUID) ([]ListStaffRow, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error)
+	LockActivePVZ(ctx context.Context, id uuid.UUID) (string, error)
+	// Serialises the transactions publishing events until they commit, so event
+	// ids are handed out in commit order and a client resuming after an id never
+	// misses an event committed later with a lower one.
+	LockEventStream(ctx context.Context) error
+	LockPVZ(ctx context.Context, id uuid.UUID) (sql.NullTime, error)
+	// Holds the reception open, like AddProduct's FOR SHARE, while a batch is inserted
+	LockReceptionInProgress(ctx context.Context, id uuid.UUID) (LockReceptionInProgressRow, error)
+	// Goes through a prepared statement, so /readyz also notices statements
+	// invalidated by a schema change.
+	Ping(ctx context.Context) (int32, error)
+	// Stores the event and NOTIFYs it; listeners only hear it if the
+	// surrounding transaction commits.
+	PublishEvent(ctx context.Context, arg PublishEventParams) error
+	// PublishEvent for every product of a batch, in scan order.
+	PublishProductEvents(ctx context.Context, arg PublishProductEventsParams) error
+	ReleaseIdempotencyKey(ctx context.Context, arg ReleaseIdempotencyKeyParams) error
+	RevokeAccessToken(ctx context.Context, arg RevokeAccessTokenParams) error
+	RevokeRefreshToken(ctx context.Context, arg RevokeRefreshTokenParams) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+	SetReceptionStatus(ctx context.Context, arg SetReceptionStatusParams) (SetReceptionStatusRow, error)
+	UnassignStaff(ctx context.Context, arg UnassignStaffParams) (int64, error)
+	UpdatePVZ(ctx context.Context, arg UpdatePVZParams) (UpdatePVZRow, error)
+	UpdateUser(ctx context.Context, arg UpdateUserParams) (UpdateUserRow, error)
+	UpdateUserPasswordHash(ctx context.Context, arg UpdateUserPasswordHashParams) error
+	UpsertCity(ctx context.Context, arg UpsertCityParams) (UpsertCityRow, error)
+	UpsertProductType(ctx context.Context, arg UpsertProductTypeParams) (UpsertProductTypeRow, error)
+	UpsertRole(ctx context.Context, arg UpsertRoleParams) error
 }
 
 var _ Querier = (*Queries)(nil)
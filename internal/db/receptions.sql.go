@@ -8,13 +8,38 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+const addReceptionTransition = `-- name: AddReceptionTransition :exec
+INSERT INTO reception_transitions (reception_id, from_status, to_status, reason, actor_id)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type AddReceptionTransitionParams struct {
+	ReceptionID uuid.UUID      `db:"reception_id" json:"reception_id"`
+	FromStatus  string         `db:"from_status" json:"from_status"`
+	ToStatus    string         `db:"to_status" json:"to_status"`
+	Reason      sql.NullString `db:"reason" json:"reason"`
+	ActorID     uuid.NullUUID  `db:"actor_id" json:"actor_id"`
+}
+
+func (q *Queries) AddReceptionTransition(ctx context.Context, arg AddReceptionTransitionParams) error {
+	_, err := q.exec(ctx, q.addReceptionTransitionStmt, addReceptionTransition,
+		arg.ReceptionID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Reason,
+		arg.ActorID,
+	)
+	return err
+}
+
 const closeReception = `-- name: CloseReception :one
 WITH reception_to_close AS (
-    SELECT id, date_time, pvz_id, status 
+    SELECT id, date_time, pvz_id, status, created_by, closed_by
     FROM receptions
     WHERE receptions.pvz_id = $1 AND receptions.status = 'in_progress'
     ORDER BY date_time DESC
@@ -23,38 +48,83 @@ WITH reception_to_close AS (
 ),
 updated_reception AS (
     UPDATE receptions r
-    SET status = 'close', updated_at = NOW()
+    SET status = 'closed', closed_by = $2, closed_at = NOW(), updated_at = NOW()
     FROM reception_to_close rtc
     WHERE r.id = rtc.id
-    RETURNING r.id, r.date_time, r.pvz_id, r.status
+    RETURNING r.id, r.date_time, r.pvz_id, r.status, r.created_by, r.closed_by
 )
-SELECT id, date_time, pvz_id, status FROM updated_reception
+SELECT id, date_time, pvz_id, status, created_by, closed_by FROM updated_reception
 UNION ALL
-SELECT id, date_time, pvz_id, status
+SELECT id, date_time, pvz_id, status, created_by, closed_by
 FROM reception_to_close
 WHERE NOT EXISTS (SELECT 1 FROM updated_reception)
 LIMIT 1
 `
 
+type CloseReceptionParams struct {
+	PvzID    uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	ClosedBy uuid.NullUUID `db:"closed_by" json:"closed_by"`
+}
+
 type CloseReceptionRow struct {
-	ID       uuid.UUID    `db:"id" json:"id"`
-	DateTime sql.NullTime `db:"date_time" json:"date_time"`
-	PvzID    uuid.UUID    `db:"pvz_id" json:"pvz_id"`
-	Status   string       `db:"status" json:"status"`
+	ID        uuid.UUID     `db:"id" json:"id"`
+	DateTime  sql.NullTime  `db:"date_time" json:"date_time"`
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	Status    string        `db:"status" json:"status"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	ClosedBy  uuid.NullUUID `db:"closed_by" json:"closed_by"`
 }
 
-func (q *Queries) CloseReception(ctx context.Context, pvzID uuid.UUID) (CloseReceptionRow, error) {
-	row := q.queryRow(ctx, q.closeReceptionStmt, closeReception, pvzID)
+func (q *Queries) CloseReception(ctx context.Context, arg CloseReceptionParams) (CloseReceptionRow, error) {
+	row := q.queryRow(ctx, q.closeReceptionStmt, closeReception, arg.PvzID, arg.ClosedBy)
 	var i CloseReceptionRow
 	err := row.Scan(
 		&i.ID,
 		&i.DateTime,
 		&i.PvzID,
 		&i.Status,
+		&i.CreatedBy,
+		&i.ClosedBy,
 	)
 	return i, err
 }
 
+const countOpenReceptionsByCity = `-- name: CountOpenReceptionsByCity :many
+SELECT p.city, COUNT(*) AS open_receptions
+FROM receptions r
+JOIN pvz p ON p.id = r.pvz_id
+WHERE r.status = 'in_progress'
+GROUP BY p.city
+`
+
+type CountOpenReceptionsByCityRow struct {
+	City           string `db:"city" json:"city"`
+	OpenReceptions int64  `db:"open_receptions" json:"open_receptions"`
+}
+
+func (q *Queries) CountOpenReceptionsByCity(ctx context.Context) ([]CountOpenReceptionsByCityRow, error) {
+	rows, err := q.query(ctx, q.countOpenReceptionsByCityStmt, countOpenReceptionsByCity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountOpenReceptionsByCityRow
+	for rows.Next() {
+		var i CountOpenReceptionsByCityRow
+		if err := rows.Scan(&i.City, &i.OpenReceptions); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createOrGetReception = `-- name: CreateOrGetReception :one
 WITH existing_reception AS (
     SELECT id FROM receptions
@@ -63,37 +133,140 @@ WITH existing_reception AS (
     FOR UPDATE SKIP LOCKED
 ),
 new_reception AS (
-    INSERT INTO receptions (pvz_id, status)
-    SELECT $1, 'in_progress'
+    INSERT INTO receptions (pvz_id, status, created_by)
+    SELECT $1, 'in_progress', $2
     WHERE NOT EXISTS (SELECT 1 FROM existing_reception)
-    RETURNING id, date_time, pvz_id, status
+    RETURNING id, date_time, pvz_id, status, created_by, closed_by
 )
-SELECT id, date_time, pvz_id, status FROM new_reception
+SELECT id, date_time, pvz_id, status, created_by, closed_by FROM new_reception
 UNION ALL
 SELECT 
     r.id, 
     r.date_time, 
     r.pvz_id, 
-    r.status
+    r.status,
+    r.created_by,
+    r.closed_by
 FROM existing_reception er
 JOIN receptions r ON er.id = r.id
 `
 
+type CreateOrGetReceptionParams struct {
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+}
+
 type CreateOrGetReceptionRow struct {
-	ID       uuid.UUID    `db:"id" json:"id"`
-	DateTime sql.NullTime `db:"date_time" json:"date_time"`
-	PvzID    uuid.UUID    `db:"pvz_id" json:"pvz_id"`
-	Status   string       `db:"status" json:"status"`
+	ID        uuid.UUID     `db:"id" json:"id"`
+	DateTime  sql.NullTime  `db:"date_time" json:"date_time"`
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	Status    string        `db:"status" json:"status"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	ClosedBy  uuid.NullUUID `db:"closed_by" json:"closed_by"`
 }
 
-func (q *Queries) CreateOrGetReception(ctx context.Context, pvzID uuid.UUID) (CreateOrGetReceptionRow, error) {
-	row := q.queryRow(ctx, q.createOrGetReceptionStmt, createOrGetReception, pvzID)
+func (q *Queries) CreateOrGetReception(ctx context.Context, arg CreateOrGetReceptionParams) (CreateOrGetReceptionRow, error) {
+	row := q.queryRow(ctx, q.createOrGetReceptionStmt, createOrGetReception, arg.PvzID, arg.CreatedBy)
 	var i CreateOrGetReceptionRow
 	err := row.Scan(
 		&i.ID,
 		&i.DateTime,
 		&i.PvzID,
 		&i.Status,
+		&i.CreatedBy,
+		&i.ClosedBy,
+	)
+	return i, err
+}
+
+const getCurrentReception = `-- name: GetCurrentReception :one
+SELECT id, date_time, pvz_id, status, created_by, closed_by
+FROM receptions
+WHERE pvz_id = $1 AND status = 'in_progress'
+ORDER BY date_time DESC
+LIMIT 1
+`
+
+type GetCurrentReceptionRow struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	DateTime  sql.NullTime  `db:"date_time" json:"date_time"`
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	Status    string        `db:"status" json:"status"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	ClosedBy  uuid.NullUUID `db:"closed_by" json:"closed_by"`
+}
+
+func (q *Queries) GetCurrentReception(ctx context.Context, pvzID uuid.UUID) (GetCurrentReceptionRow, error) {
+	row := q.queryRow(ctx, q.getCurrentReceptionStmt, getCurrentReception, pvzID)
+	var i GetCurrentReceptionRow
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.CreatedBy,
+		&i.ClosedBy,
+	)
+	return i, err
+}
+
+const getReception = `-- name: GetReception :one
+SELECT id, date_time, pvz_id, status, created_by, closed_by
+FROM receptions
+WHERE id = $1
+`
+
+type GetReceptionRow struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	DateTime  sql.NullTime  `db:"date_time" json:"date_time"`
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	Status    string        `db:"status" json:"status"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	ClosedBy  uuid.NullUUID `db:"closed_by" json:"closed_by"`
+}
+
+func (q *Queries) GetReception(ctx context.Context, id uuid.UUID) (GetReceptionRow, error) {
+	row := q.queryRow(ctx, q.getReceptionStmt, getReception, id)
+	var i GetReceptionRow
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.CreatedBy,
+		&i.ClosedBy,
+	)
+	return i, err
+}
+
+const getReceptionForUpdate = `-- name: GetReceptionForUpdate :one
+SELECT id, date_time, pvz_id, status, created_by, closed_by, closed_at
+FROM receptions
+WHERE id = $1
+FOR UPDATE
+`
+
+type GetReceptionForUpdateRow struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	DateTime  sql.NullTime  `db:"date_time" json:"date_time"`
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	Status    string        `db:"status" json:"status"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	ClosedBy  uuid.NullUUID `db:"closed_by" json:"closed_by"`
+	ClosedAt  sql.NullTime  `db:"closed_at" json:"closed_at"`
+}
+
+func (q *Queries) GetReceptionForUpdate(ctx context.Context, id uuid.UUID) (GetReceptionForUpdateRow, error) {
+	row := q.queryRow(ctx, q.getReceptionForUpdateStmt, getReceptionForUpdate, id)
+	var i GetReceptionForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.CreatedBy,
+		&i.ClosedBy,
+		&i.ClosedAt,
 	)
 	return i, err
 }
@@ -111,3 +284,155 @@ func (q *Queries) HasOpenReceptions(ctx context.Context, pvzID uuid.UUID) (bool,
 	err := row.Scan(&has_open_receptions)
 	return has_open_receptions, err
 }
+
+const listReceptionTransitions = `-- name: ListReceptionTransitions :many
+SELECT id, reception_id, from_status, to_status, reason, actor_id, created_at
+FROM reception_transitions
+WHERE reception_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListReceptionTransitions(ctx context.Context, receptionID uuid.UUID) ([]ReceptionTransition, error) {
+	rows, err := q.query(ctx, q.listReceptionTransitionsStmt, listReceptionTransitions, receptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReceptionTransition
+	for rows.Next() {
+		var i ReceptionTransition
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReceptionID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Reason,
+			&i.ActorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReceptions = `-- name: ListReceptions :many
+SELECT id, date_time, pvz_id, status, created_by, closed_by
+FROM receptions
+WHERE pvz_id = $1
+    AND ($2::text = '' OR status = $2::text)
+    AND date_time BETWEEN COALESCE($3, '-infinity'::timestamp)
+                      AND COALESCE($4, 'infinity'::timestamp)
+    AND (NOT $5::boolean OR (date_time, id) < ($6::timestamptz, $7::uuid))
+ORDER BY date_time DESC, id DESC
+LIMIT $8
+`
+
+type ListReceptionsParams struct {
+	PvzID      uuid.UUID    `db:"pvz_id" json:"pvz_id"`
+	Column2    string       `db:"column_2" json:"column_2"`
+	DateTime   sql.NullTime `db:"date_time" json:"date_time"`
+	DateTime_2 sql.NullTime `db:"date_time_2" json:"date_time_2"`
+	Column5    bool         `db:"column_5" json:"column_5"`
+	Column6    time.Time    `db:"column_6" json:"column_6"`
+	Column7    uuid.UUID    `db:"column_7" json:"column_7"`
+	Limit      int32        `db:"limit" json:"limit"`
+}
+
+type ListReceptionsRow struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	DateTime  sql.NullTime  `db:"date_time" json:"date_time"`
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	Status    string        `db:"status" json:"status"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	ClosedBy  uuid.NullUUID `db:"closed_by" json:"closed_by"`
+}
+
+// Keyset pagination: the cursor is the (date_time, id) of the last row of the previous page
+func (q *Queries) ListReceptions(ctx context.Context, arg ListReceptionsParams) ([]ListReceptionsRow, error) {
+	rows, err := q.query(ctx, q.listReceptionsStmt, listReceptions,
+		arg.PvzID,
+		arg.Column2,
+		arg.DateTime,
+		arg.DateTime_2,
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReceptionsRow
+	for rows.Next() {
+		var i ListReceptionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.PvzID,
+			&i.Status,
+			&i.CreatedBy,
+			&i.ClosedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setReceptionStatus = `-- name: SetReceptionStatus :one
+UPDATE receptions
+SET status = $2, closed_by = $3, closed_at = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING id, date_time, pvz_id, status, created_by, closed_by
+`
+
+type SetReceptionStatusParams struct {
+	ID       uuid.UUID     `db:"id" json:"id"`
+	Status   string        `db:"status" json:"status"`
+	ClosedBy uuid.NullUUID `db:"closed_by" json:"closed_by"`
+	ClosedAt sql.NullTime  `db:"closed_at" json:"closed_at"`
+}
+
+type SetReceptionStatusRow struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	DateTime  sql.NullTime  `db:"date_time" json:"date_time"`
+	PvzID     uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	Status    string        `db:"status" json:"status"`
+	CreatedBy uuid.NullUUID `db:"created_by" json:"created_by"`
+	ClosedBy  uuid.NullUUID `db:"closed_by" json:"closed_by"`
+}
+
+func (q *Queries) SetReceptionStatus(ctx context.Context, arg SetReceptionStatusParams) (SetReceptionStatusRow, error) {
+	row := q.queryRow(ctx, q.setReceptionStatusStmt, setReceptionStatus,
+		arg.ID,
+		arg.Status,
+		arg.ClosedBy,
+		arg.ClosedAt,
+	)
+	var i SetReceptionStatusRow
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.CreatedBy,
+		&i.ClosedBy,
+	)
+	return i, err
+}
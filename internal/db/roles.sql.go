@@ -0,0 +1,123 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: roles.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const addRolePermission = `-- name: AddRolePermission :exec
+INSERT INTO role_permissions (role, permission, scope)
+VALUES ($1, $2, $3)
+`
+
+type AddRolePermissionParams struct {
+	Role       string `db:"role" json:"role"`
+	Permission string `db:"permission" json:"permission"`
+	Scope      string `db:"scope" json:"scope"`
+}
+
+func (q *Queries) AddRolePermission(ctx context.Context, arg AddRolePermissionParams) error {
+	_, err := q.exec(ctx, q.addRolePermissionStmt, addRolePermission, arg.Role, arg.Permission, arg.Scope)
+	return err
+}
+
+const deleteRolePermissions = `-- name: DeleteRolePermissions :exec
+DELETE FROM role_permissions
+WHERE role = $1
+`
+
+func (q *Queries) DeleteRolePermissions(ctx context.Context, role string) error {
+	_, err := q.exec(ctx, q.deleteRolePermissionsStmt, deleteRolePermissions, role)
+	return err
+}
+
+const listRolePermissions = `-- name: ListRolePermissions :many
+SELECT r.name AS role, rp.permission, rp.scope
+FROM roles r
+LEFT JOIN role_permissions rp ON rp.role = r.name
+ORDER BY r.name, rp.permission
+`
+
+type ListRolePermissionsRow struct {
+	Role       string         `db:"role" json:"role"`
+	Permission sql.NullString `db:"permission" json:"permission"`
+	Scope      sql.NullString `db:"scope" json:"scope"`
+}
+
+func (q *Queries) ListRolePermissions(ctx context.Context) ([]ListRolePermissionsRow, error) {
+	rows, err := q.query(ctx, q.listRolePermissionsStmt, listRolePermissions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRolePermissionsRow
+	for rows.Next() {
+		var i ListRolePermissionsRow
+		if err := rows.Scan(&i.Role, &i.Permission, &i.Scope); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoles = `-- name: ListRoles :many
+SELECT name, description
+FROM roles
+ORDER BY name
+`
+
+type ListRolesRow struct {
+	Name        string `db:"name" json:"name"`
+	Description string `db:"description" json:"description"`
+}
+
+func (q *Queries) ListRoles(ctx context.Context) ([]ListRolesRow, error) {
+	rows, err := q.query(ctx, q.listRolesStmt, listRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRolesRow
+	for rows.Next() {
+		var i ListRolesRow
+		if err := rows.Scan(&i.Name, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertRole = `-- name: UpsertRole :exec
+INSERT INTO roles (name, description)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET description = EXCLUDED.description
+`
+
+type UpsertRoleParams struct {
+	Name        string `db:"name" json:"name"`
+	Description string `db:"description" json:"description"`
+}
+
+func (q *Queries) UpsertRole(ctx context.Context, arg UpsertRoleParams) error {
+	_, err := q.exec(ctx, q.upsertRoleStmt, upsertRole, arg.Name, arg.Description)
+	return err
+}
@@ -0,0 +1,124 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: staff.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const assignStaff = `-- name: AssignStaff :one
+INSERT INTO pvz_staff (pvz_id, user_id, assigned_by)
+SELECT $1, $2, $3
+WHERE EXISTS (
+    SELECT 1 FROM users
+    WHERE id = $2 AND role = 'employee'
+)
+ON CONFLICT (pvz_id, user_id) DO UPDATE SET assigned_by = EXCLUDED.assigned_by, assigned_at = NOW()
+RETURNING pvz_id, user_id, assigned_by, assigned_at
+`
+
+type AssignStaffParams struct {
+	PvzID      uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	UserID     uuid.UUID     `db:"user_id" json:"user_id"`
+	AssignedBy uuid.NullUUID `db:"assigned_by" json:"assigned_by"`
+}
+
+func (q *Queries) AssignStaff(ctx context.Context, arg AssignStaffParams) (PvzStaff, error) {
+	row := q.queryRow(ctx, q.assignStaffStmt, assignStaff, arg.PvzID, arg.UserID, arg.AssignedBy)
+	var i PvzStaff
+	err := row.Scan(
+		&i.PvzID,
+		&i.UserID,
+		&i.AssignedBy,
+		&i.AssignedAt,
+	)
+	return i, err
+}
+
+const isStaffAssigned = `-- name: IsStaffAssigned :one
+SELECT EXISTS (
+    SELECT 1 FROM pvz_staff
+    WHERE pvz_id = $1 AND user_id = $2
+) AS assigned
+`
+
+type IsStaffAssignedParams struct {
+	PvzID  uuid.UUID `db:"pvz_id" json:"pvz_id"`
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) IsStaffAssigned(ctx context.Context, arg IsStaffAssignedParams) (bool, error) {
+	row := q.queryRow(ctx, q.isStaffAssignedStmt, isStaffAssigned, arg.PvzID, arg.UserID)
+	var assigned bool
+	err := row.Scan(&assigned)
+	return assigned, err
+}
+
+const listStaff = `-- name: ListStaff :many
+SELECT s.pvz_id, s.user_id, u.email, s.assigned_by, s.assigned_at
+FROM pvz_staff s
+JOIN users u ON u.id = s.user_id
+WHERE s.pvz_id = $1
+ORDER BY s.assigned_at
+`
+
+type ListStaffRow struct {
+	PvzID      uuid.UUID     `db:"pvz_id" json:"pvz_id"`
+	UserID     uuid.UUID     `db:"user_id" json:"user_id"`
+	Email      string        `db:"email" json:"email"`
+	AssignedBy uuid.NullUUID `db:"assigned_by" json:"assigned_by"`
+	AssignedAt sql.NullTime  `db:"assigned_at" json:"assigned_at"`
+}
+
+func (q *Queries) ListStaff(ctx context.Context, pvzID uuid.UUID) ([]ListStaffRow, error) {
+	rows, err := q.query(ctx, q.listStaffStmt, listStaff, pvzID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStaffRow
+	for rows.Next() {
+		var i ListStaffRow
+		if err := rows.Scan(
+			&i.PvzID,
+			&i.UserID,
+			&i.Email,
+			&i.AssignedBy,
+			&i.AssignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unassignStaff = `-- name: UnassignStaff :execrows
+DELETE FROM pvz_staff
+WHERE pvz_id = $1 AND user_id = $2
+`
+
+type UnassignStaffParams struct {
+	PvzID  uuid.UUID `db:"pvz_id" json:"pvz_id"`
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) UnassignStaff(ctx context.Context, arg UnassignStaffParams) (int64, error) {
+	result, err := q.exec(ctx, q.unassignStaffStmt, unassignStaff, arg.PvzID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
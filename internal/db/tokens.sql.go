@@ -0,0 +1,134 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: tokens.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createRefreshToken = `-- name: CreateRefreshToken :one
+INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id
+`
+
+type CreateRefreshTokenParams struct {
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	FamilyID  uuid.UUID `db:"family_id" json:"family_id"`
+	TokenHash string    `db:"token_hash" json:"token_hash"`
+	ExpiresAt time.Time `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (uuid.UUID, error) {
+	row := q.queryRow(ctx, q.createRefreshTokenStmt, createRefreshToken,
+		arg.UserID,
+		arg.FamilyID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteExpiredRevokedTokens = `-- name: DeleteExpiredRevokedTokens :exec
+DELETE FROM revoked_tokens
+WHERE expires_at < NOW()
+`
+
+func (q *Queries) DeleteExpiredRevokedTokens(ctx context.Context) error {
+	_, err := q.exec(ctx, q.deleteExpiredRevokedTokensStmt, deleteExpiredRevokedTokens)
+	return err
+}
+
+const getRefreshTokenForUpdate = `-- name: GetRefreshTokenForUpdate :one
+SELECT id, user_id, family_id, expires_at, revoked_at
+FROM refresh_tokens
+WHERE token_hash = $1
+FOR UPDATE
+`
+
+type GetRefreshTokenForUpdateRow struct {
+	ID        uuid.UUID    `db:"id" json:"id"`
+	UserID    uuid.UUID    `db:"user_id" json:"user_id"`
+	FamilyID  uuid.UUID    `db:"family_id" json:"family_id"`
+	ExpiresAt time.Time    `db:"expires_at" json:"expires_at"`
+	RevokedAt sql.NullTime `db:"revoked_at" json:"revoked_at"`
+}
+
+func (q *Queries) GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (GetRefreshTokenForUpdateRow, error) {
+	row := q.queryRow(ctx, q.getRefreshTokenForUpdateStmt, getRefreshTokenForUpdate, tokenHash)
+	var i GetRefreshTokenForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.FamilyID,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const isAccessTokenRevoked = `-- name: IsAccessTokenRevoked :one
+SELECT EXISTS (
+    SELECT 1 FROM revoked_tokens
+    WHERE jti = $1
+) AS revoked
+`
+
+func (q *Queries) IsAccessTokenRevoked(ctx context.Context, jti uuid.UUID) (bool, error) {
+	row := q.queryRow(ctx, q.isAccessTokenRevokedStmt, isAccessTokenRevoked, jti)
+	var revoked bool
+	err := row.Scan(&revoked)
+	return revoked, err
+}
+
+const revokeAccessToken = `-- name: RevokeAccessToken :exec
+INSERT INTO revoked_tokens (jti, expires_at)
+VALUES ($1, $2)
+ON CONFLICT (jti) DO NOTHING
+`
+
+type RevokeAccessTokenParams struct {
+	Jti       uuid.UUID `db:"jti" json:"jti"`
+	ExpiresAt time.Time `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) RevokeAccessToken(ctx context.Context, arg RevokeAccessTokenParams) error {
+	_, err := q.exec(ctx, q.revokeAccessTokenStmt, revokeAccessToken, arg.Jti, arg.ExpiresAt)
+	return err
+}
+
+const revokeRefreshToken = `-- name: RevokeRefreshToken :exec
+UPDATE refresh_tokens
+SET revoked_at = NOW(), replaced_by = $2
+WHERE id = $1
+`
+
+type RevokeRefreshTokenParams struct {
+	ID         uuid.UUID     `db:"id" json:"id"`
+	ReplacedBy uuid.NullUUID `db:"replaced_by" json:"replaced_by"`
+}
+
+func (q *Queries) RevokeRefreshToken(ctx context.Context, arg RevokeRefreshTokenParams) error {
+	_, err := q.exec(ctx, q.revokeRefreshTokenStmt, revokeRefreshToken, arg.ID, arg.ReplacedBy)
+	return err
+}
+
+const revokeRefreshTokenFamily = `-- name: RevokeRefreshTokenFamily :exec
+UPDATE refresh_tokens
+SET revoked_at = NOW()
+WHERE family_id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	_, err := q.exec(ctx, q.revokeRefreshTokenFamilyStmt, revokeRefreshTokenFamily, familyID)
+	return err
+}
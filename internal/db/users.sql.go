@@ -7,49 +7,223 @@ package db
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (email, password_hash, role) 
-VALUES ($1, md5($2), $3)
-RETURNING id, email, role
+INSERT INTO users (email, password_hash, role, city) 
+VALUES ($1, $2, $3, $4)
+RETURNING id, email, role, city
 `
 
 type CreateUserParams struct {
-	Email string `db:"email" json:"email"`
-	Md5   []byte `db:"md5" json:"md5"`
-	Role  string `db:"role" json:"role"`
+	Email        string         `db:"email" json:"email"`
+	PasswordHash string         `db:"password_hash" json:"password_hash"`
+	Role         string         `db:"role" json:"role"`
+	City         sql.NullString `db:"city" json:"city"`
 }
 
 type CreateUserRow struct {
-	ID    uuid.UUID `db:"id" json:"id"`
-	Email string    `db:"email" json:"email"`
-	Role  string    `db:"role" json:"role"`
+	ID    uuid.UUID      `db:"id" json:"id"`
+	Email string         `db:"email" json:"email"`
+	Role  string         `db:"role" json:"role"`
+	City  sql.NullString `db:"city" json:"city"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error) {
-	row := q.queryRow(ctx, q.createUserStmt, createUser, arg.Email, arg.Md5, arg.Role)
+	row := q.queryRow(ctx, q.createUserStmt, createUser,
+		arg.Email,
+		arg.PasswordHash,
+		arg.Role,
+		arg.City,
+	)
 	var i CreateUserRow
-	err := row.Scan(&i.ID, &i.Email, &i.Role)
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Role,
+		&i.City,
+	)
+	return i, err
+}
+
+const getOrCreateUser = `-- name: GetOrCreateUser :one
+INSERT INTO users (email, password_hash, role) 
+VALUES ($1, $2, $3)
+ON CONFLICT (email) DO UPDATE SET updated_at = NOW()
+RETURNING id, email, role, city
+`
+
+type GetOrCreateUserParams struct {
+	Email        string `db:"email" json:"email"`
+	PasswordHash string `db:"password_hash" json:"password_hash"`
+	Role         string `db:"role" json:"role"`
+}
+
+type GetOrCreateUserRow struct {
+	ID    uuid.UUID      `db:"id" json:"id"`
+	Email string         `db:"email" json:"email"`
+	Role  string         `db:"role" json:"role"`
+	City  sql.NullString `db:"city" json:"city"`
+}
+
+func (q *Queries) GetOrCreateUser(ctx context.Context, arg GetOrCreateUserParams) (GetOrCreateUserRow, error) {
+	row := q.queryRow(ctx, q.getOrCreateUserStmt, getOrCreateUser, arg.Email, arg.PasswordHash, arg.Role)
+	var i GetOrCreateUserRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Role,
+		&i.City,
+	)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, email, password_hash, role, city 
+FROM users 
+WHERE email = $1
+`
+
+type GetUserByEmailRow struct {
+	ID           uuid.UUID      `db:"id" json:"id"`
+	Email        string         `db:"email" json:"email"`
+	PasswordHash string         `db:"password_hash" json:"password_hash"`
+	Role         string         `db:"role" json:"role"`
+	City         sql.NullString `db:"city" json:"city"`
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
+	row := q.queryRow(ctx, q.getUserByEmailStmt, getUserByEmail, email)
+	var i GetUserByEmailRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Role,
+		&i.City,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, email, role, city 
+FROM users 
+WHERE id = $1
+`
+
+type GetUserByIDRow struct {
+	ID    uuid.UUID      `db:"id" json:"id"`
+	Email string         `db:"email" json:"email"`
+	Role  string         `db:"role" json:"role"`
+	City  sql.NullString `db:"city" json:"city"`
+}
+
+func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (GetUserByIDRow, error) {
+	row := q.queryRow(ctx, q.getUserByIDStmt, getUserByID, id)
+	var i GetUserByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Role,
+		&i.City,
+	)
 	return i, err
 }
 
-const getUserByCredentials = `-- name: GetUserByCredentials :one
-SELECT role 
+const listUsers = `-- name: ListUsers :many
+SELECT id, email, role, city 
 FROM users 
-WHERE email = $1 AND password_hash = md5($2)
+ORDER BY created_at, id
+LIMIT $1 OFFSET (($2::int - 1) * $1)
+`
+
+type ListUsersParams struct {
+	Limit   int32 `db:"limit" json:"limit"`
+	Column2 int32 `db:"column_2" json:"column_2"`
+}
+
+type ListUsersRow struct {
+	ID    uuid.UUID      `db:"id" json:"id"`
+	Email string         `db:"email" json:"email"`
+	Role  string         `db:"role" json:"role"`
+	City  sql.NullString `db:"city" json:"city"`
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error) {
+	rows, err := q.query(ctx, q.listUsersStmt, listUsers, arg.Limit, arg.Column2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersRow
+	for rows.Next() {
+		var i ListUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Role,
+			&i.City,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateUser = `-- name: UpdateUser :one
+UPDATE users
+SET role = $2, city = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, role, city
+`
+
+type UpdateUserParams struct {
+	ID   uuid.UUID      `db:"id" json:"id"`
+	Role string         `db:"role" json:"role"`
+	City sql.NullString `db:"city" json:"city"`
+}
+
+type UpdateUserRow struct {
+	ID    uuid.UUID      `db:"id" json:"id"`
+	Email string         `db:"email" json:"email"`
+	Role  string         `db:"role" json:"role"`
+	City  sql.NullString `db:"city" json:"city"`
+}
+
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (UpdateUserRow, error) {
+	row := q.queryRow(ctx, q.updateUserStmt, updateUser, arg.ID, arg.Role, arg.City)
+	var i UpdateUserRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Role,
+		&i.City,
+	)
+	return i, err
+}
+
+const updateUserPasswordHash = `-- name: UpdateUserPasswordHash :exec
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1
 `
 
-type GetUserByCredentialsParams struct {
-	Email string `db:"email" json:"email"`
-	Md5   []byte `db:"md5" json:"md5"`
+type UpdateUserPasswordHashParams struct {
+	ID           uuid.UUID `db:"id" json:"id"`
+	PasswordHash string    `db:"password_hash" json:"password_hash"`
 }
 
-func (q *Queries) GetUserByCredentials(ctx context.Context, arg GetUserByCredentialsParams) (string, error) {
-	row := q.queryRow(ctx, q.getUserByCredentialsStmt, getUserByCredentials, arg.Email, arg.Md5)
-	var role string
-	err := row.Scan(&role)
-	return role, err
+func (q *Queries) UpdateUserPasswordHash(ctx context.Context, arg UpdateUserPasswordHashParams) error {
+	_, err := q.exec(ctx, q.updateUserPasswordHashStmt, updateUserPasswordHash, arg.ID, arg.PasswordHash)
+	return err
 }
@@ -0,0 +1,137 @@
+package grpcserver
+
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/api/pvzpb"
+	"github.com/wisp167/pvz/internal/data"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The data layer and the handlers' row converters speak the REST types, so
+// the responses are built from those rather than from the sqlc rows.
+
+func pvzToProto(pvz api.PVZ) *pvzpb.PVZ {
+	return &pvzpb.PVZ{
+		Id:               uuidString(pvz.Id),
+		RegistrationDate: timestamp(pvz.RegistrationDate),
+		City:             pvz.City,
+		DeactivatedAt:    timestamp(pvz.DeactivatedAt),
+	}
+}
+
+func receptionToProto(reception api.Reception) *pvzpb.Reception {
+	return &pvzpb.Reception{
+		Id:        uuidString(reception.Id),
+		DateTime:  timestamppb.New(reception.DateTime),
+		PvzId:     reception.PvzId.String(),
+		Status:    receptionStatusToProto(reception.Status),
+		CreatedBy: uuidString(reception.CreatedBy),
+		ClosedBy:  uuidString(reception.ClosedBy),
+	}
+}
+
+func receptionStatusToProto(status api.ReceptionStatus) pvzpb.ReceptionStatus {
+	switch status {
+	case api.ReceptionStatusInProgress:
+		return pvzpb.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+	case api.ReceptionStatusClosed:
+		return pvzpb.ReceptionStatus_RECEPTION_STATUS_CLOSED
+	case api.ReceptionStatusCancelled:
+		return pvzpb.ReceptionStatus_RECEPTION_STATUS_CANCELLED
+	case api.ReceptionStatusVerified:
+		return pvzpb.ReceptionStatus_RECEPTION_STATUS_VERIFIED
+	default:
+		return pvzpb.ReceptionStatus_RECEPTION_STATUS_UNSPECIFIED
+	}
+}
+
+func productToProto(product api.Product) *pvzpb.Product {
+	out := &pvzpb.Product{
+		Id:          uuidString(product.Id),
+		DateTime:    timestamp(product.DateTime),
+		Type:        product.Type,
+		ReceptionId: product.ReceptionId.String(),
+		CreatedBy:   uuidString(product.CreatedBy),
+		DeletedAt:   timestamp(product.DeletedAt),
+		DeletedBy:   uuidString(product.DeletedBy),
+	}
+	if product.Barcode != nil {
+		out.Barcode = *product.Barcode
+	}
+	if product.Sku != nil {
+		out.Sku = *product.Sku
+	}
+	if product.WeightGrams != nil {
+		out.WeightGrams = int32(*product.WeightGrams)
+	}
+	if d := product.Dimensions; d != nil {
+		out.Dimensions = &pvzpb.Dimensions{
+			LengthMm: int32(d.LengthMm),
+			WidthMm:  int32(d.WidthMm),
+			HeightMm: int32(d.HeightMm),
+		}
+	}
+	return out
+}
+
+func pvzListToProto(items []data.PVZWithReceptionsResponse) *pvzpb.ListPVZResponse {
+	resp := &pvzpb.ListPVZResponse{Items: make([]*pvzpb.ListPVZResponse_Item, 0, len(items))}
+	for _, item := range items {
+		out := &pvzpb.ListPVZResponse_Item{Pvz: pvzToProto(item.PVZ)}
+		for _, reception := range item.Receptions {
+			products := make([]*pvzpb.Product, 0, len(reception.Products))
+			for _, product := range reception.Products {
+				products = append(products, productToProto(product))
+			}
+			out.Receptions = append(out.Receptions, &pvzpb.ListPVZResponse_ReceptionWithProducts{
+				Reception: receptionToProto(reception.Reception),
+				Products:  products,
+			})
+		}
+		resp.Items = append(resp.Items, out)
+	}
+	return resp
+}
+
+// productRequestToAPI maps the zero values proto3 uses for "not set" back
+// to nil, so handlers.ValidProductDetails applies unchanged.
+func productRequestToAPI(req *pvzpb.AddProductRequest, pvzID openapi_types.UUID) api.PostProductsJSONBody {
+	body := api.PostProductsJSONBody{PvzId: pvzID, Type: req.GetType()}
+	if req.GetBarcode() != "" {
+		barcode := req.GetBarcode()
+		body.Barcode = &barcode
+	}
+	if req.GetSku() != "" {
+		sku := req.GetSku()
+		body.Sku = &sku
+	}
+	if req.GetWeightGrams() != 0 {
+		weight := int(req.GetWeightGrams())
+		body.WeightGrams = &weight
+	}
+	if d := req.GetDimensions(); d != nil {
+		body.Dimensions = &api.Dimensions{
+			LengthMm: int(d.GetLengthMm()),
+			WidthMm:  int(d.GetWidthMm()),
+			HeightMm: int(d.GetHeightMm()),
+		}
+	}
+	return body
+}
+
+func uuidString(id *openapi_types.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,195 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/wisp167/pvz/api/pvzpb"
+	"github.com/wisp167/pvz/internal/handlers"
+	"github.com/wisp167/pvz/internal/logging"
+	"github.com/wisp167/pvz/internal/policy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// permissions is the gRPC counterpart of the require(...) calls in
+// handlers.RegisterHandlersMiddleware. Methods of other services (health,
+// reflection) need no token.
+var permissions = map[string]string{
+	pvzpb.PVZService_ListPVZ_FullMethodName:            policy.PVZRead,
+	pvzpb.PVZService_CreatePVZ_FullMethodName:          policy.PVZCreate,
+	pvzpb.PVZService_CreateReception_FullMethodName:    policy.ReceptionCreate,
+	pvzpb.PVZService_CloseLastReception_FullMethodName: policy.ReceptionClose,
+	pvzpb.PVZService_AddProduct_FullMethodName:         policy.ProductCreate,
+	pvzpb.PVZService_DeleteLastProduct_FullMethodName:  policy.ProductDelete,
+}
+
+var servicePrefix = "/" + pvzpb.PVZService_ServiceDesc.ServiceName + "/"
+
+// principal is the authenticated caller and the scope of the grant that let
+// the call through.
+type principal struct {
+	UserID uuid.UUID
+	Role   string
+	City   string
+	Scope  policy.Scope
+}
+
+type principalKey struct{}
+
+func principalFrom(ctx context.Context) (principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(principal)
+	return p, ok
+}
+
+// callAttrs lets the auth interceptor hand the caller back to logInterceptor,
+// which wraps it and so never sees the context it passes on.
+type callAttrs struct {
+	args []any
+}
+
+type callAttrsKey struct{}
+
+// logInterceptor gives every call a request ID, taken from x-request-id
+// metadata when present, and logs one line per call like
+// handlers.RequestLogger.
+func logInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		requestID := firstMetadata(ctx, "x-request-id")
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.NewString()
+		}
+		grpc.SetHeader(ctx, metadata.Pairs("x-request-id", requestID))
+
+		ctx = logging.With(ctx, "request_id", requestID, "route", info.FullMethod)
+		if r, ok := req.(interface{ GetPvzId() string }); ok && r.GetPvzId() != "" {
+			ctx = logging.With(ctx, "pvz_id", r.GetPvzId())
+		}
+		caller := &callAttrs{}
+		ctx = context.WithValue(ctx, callAttrsKey{}, caller)
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		level := slog.LevelInfo
+		switch code {
+		case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+			level = slog.LevelError
+		}
+		logger.Log(logging.With(ctx, caller.args...), level, "request",
+			"method", info.FullMethod,
+			"code", code.String(),
+			"duration", time.Since(start),
+		)
+		return resp, err
+	}
+}
+
+// authInterceptor checks the bearer token and the method's permission with
+// the same handlers.Authenticator and handlers.Authorizer as the REST API.
+func authInterceptor(authenticate handlers.Authenticator, authz handlers.Authorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(ctx, req)
+		}
+		permission, ok := permissions[info.FullMethod]
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "Access denied")
+		}
+
+		claims, userID, err := authenticate(ctx, firstMetadata(ctx, "authorization"))
+		if err != nil {
+			return nil, statusFromHTTP(err)
+		}
+		ctx = logging.With(ctx, "user_id", userID.String(), "role", claims.Role)
+		if caller, ok := ctx.Value(callAttrsKey{}).(*callAttrs); ok {
+			caller.args = append(caller.args, "user_id", userID.String(), "role", claims.Role)
+		}
+
+		scope, ok := authz.Policy.Scope(claims.Role, permission)
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "Access denied")
+		}
+
+		resource, err := requestResource(req)
+		if err != nil {
+			return nil, err
+		}
+		if err := authz.CheckScope(ctx, scope, userID, claims.City, resource); err != nil {
+			return nil, statusFromHTTP(err)
+		}
+
+		ctx = context.WithValue(ctx, principalKey{}, principal{
+			UserID: userID,
+			Role:   claims.Role,
+			City:   claims.City,
+			Scope:  scope,
+		})
+		return handler(ctx, req)
+	}
+}
+
+// requestResource finds the PVZ a request touches, like the REST Authorizer
+// does with the :pvzId parameter and the pvzId and city body fields.
+func requestResource(req any) (handlers.ResourceRef, error) {
+	var ref handlers.ResourceRef
+	if r, ok := req.(interface{ GetPvzId() string }); ok {
+		pvzID, err := uuid.Parse(r.GetPvzId())
+		if err != nil {
+			return ref, status.Error(codes.InvalidArgument, "invalid pvz_id")
+		}
+		ref.PvzId = &pvzID
+	}
+	if r, ok := req.(interface{ GetCity() string }); ok && r.GetCity() != "" {
+		city := r.GetCity()
+		ref.City = &city
+	}
+	return ref, nil
+}
+
+func firstMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// statusFromHTTP turns the *echo.HTTPError returned by the shared REST checks
+// into the matching gRPC status.
+func statusFromHTTP(err error) error {
+	var he *echo.HTTPError
+	if !errors.As(err, &he) {
+		return status.Error(codes.Internal, err.Error())
+	}
+	message := fmt.Sprint(he.Message)
+
+	switch he.Code {
+	case http.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, message)
+	case http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, message)
+	case http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, message)
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, message)
+	case http.StatusConflict:
+		return status.Error(codes.AlreadyExists, message)
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
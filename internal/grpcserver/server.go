@@ -0,0 +1,225 @@
+// Package grpcserver serves the PVZ, reception and product operations over
+// gRPC (schema/pvz.proto) on top of the same data.Models and role checks as
+// the REST handlers.
+package grpcserver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/api/pvzpb"
+	"github.com/wisp167/pvz/internal/data"
+	"github.com/wisp167/pvz/internal/handlers"
+	"github.com/wisp167/pvz/internal/policy"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Server is a grpc.Server with PVZService, the standard health service and
+// reflection registered.
+type Server struct {
+	*grpc.Server
+	health *health.Server
+}
+
+func New(model *data.Models, jwt handlers.JWTConfig, logger *slog.Logger) *Server {
+	authz := handlers.Authorizer{
+		Policy:     model.Policy,
+		IsAssigned: model.IsStaffAssigned,
+		PVZCity:    model.PVZCity,
+	}
+
+	srv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			logInterceptor(logger),
+			authInterceptor(handlers.NewAuthenticator(jwt), authz),
+		),
+	)
+
+	pvzpb.RegisterPVZServiceServer(srv, &pvzService{model: model, logger: logger})
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(pvzpb.PVZService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, healthServer)
+
+	reflection.Register(srv)
+
+	return &Server{Server: srv, health: healthServer}
+}
+
+// Shutdown reports NOT_SERVING to health checks and waits for running calls
+// until ctx is done, then cancels the rest.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.Stop()
+		return ctx.Err()
+	}
+}
+
+type pvzService struct {
+	pvzpb.UnimplementedPVZServiceServer
+	model  *data.Models
+	logger *slog.Logger
+}
+
+// internalError logs err and hides it from the caller, like the REST
+// handlers' logError.
+func (s *pvzService) internalError(ctx context.Context, message string, err error) error {
+	s.logger.ErrorContext(ctx, "request failed", "error", err)
+	return status.Error(codes.Internal, message)
+}
+
+func (s *pvzService) ListPVZ(ctx context.Context, req *pvzpb.ListPVZRequest) (*pvzpb.ListPVZResponse, error) {
+	if req.GetPage() < 0 || req.GetLimit() < 0 || req.GetLimit() > 30 {
+		return nil, status.Error(codes.InvalidArgument, "page must be positive and limit between 1 and 30")
+	}
+
+	var params api.GetPvzParams
+	if req.GetStartDate() != nil {
+		start := req.GetStartDate().AsTime()
+		params.StartDate = &start
+	}
+	if req.GetEndDate() != nil {
+		end := req.GetEndDate().AsTime()
+		params.EndDate = &end
+	}
+	if req.GetPage() > 0 {
+		page := int(req.GetPage())
+		params.Page = &page
+	}
+	if req.GetLimit() > 0 {
+		limit := int(req.GetLimit())
+		params.Limit = &limit
+	}
+
+	// city-scoped readers only see the PVZs of their own city
+	var city string
+	if caller, _ := principalFrom(ctx); caller.Scope == policy.ScopeCity {
+		city = caller.City
+	}
+
+	items, err := s.model.GetPVZ(ctx, params, city)
+	if err != nil {
+		return nil, s.internalError(ctx, "failed to list pvz", err)
+	}
+	return pvzListToProto(items), nil
+}
+
+func (s *pvzService) CreatePVZ(ctx context.Context, req *pvzpb.CreatePVZRequest) (*pvzpb.PVZ, error) {
+	pvz, err := s.model.AddPVZ(ctx, api.PVZ{City: req.GetCity()})
+	if errors.Is(err, data.ErrUnknownCity) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid city")
+	}
+	if err != nil {
+		return nil, s.internalError(ctx, "failed to create pvz", err)
+	}
+	return pvzToProto(handlers.ConvertCreatePVZRowToPVZ(pvz)), nil
+}
+
+func (s *pvzService) CreateReception(ctx context.Context, req *pvzpb.CreateReceptionRequest) (*pvzpb.Reception, error) {
+	caller, pvzID, err := callerAndPVZ(ctx, req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := s.model.AddReception(ctx, api.PostReceptionsJSONBody{PvzId: pvzID}, caller.UserID)
+	if err != nil {
+		// the REST handler answers 400 for an open reception and an unknown or
+		// deactivated PVZ alike
+		s.logger.ErrorContext(ctx, "request failed", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "Failed to register reception")
+	}
+	return receptionToProto(handlers.ConvertReceptionRowToAPI(reception)), nil
+}
+
+func (s *pvzService) CloseLastReception(ctx context.Context, req *pvzpb.CloseLastReceptionRequest) (*pvzpb.Reception, error) {
+	caller, pvzID, err := callerAndPVZ(ctx, req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := s.model.CloseLastReception(ctx, pvzID, caller.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.FailedPrecondition, "no reception in progress")
+	}
+	if err != nil {
+		return nil, s.internalError(ctx, "failed to close reception", err)
+	}
+	return receptionToProto(handlers.ConvertCloseReceptionRowToAPI(reception)), nil
+}
+
+func (s *pvzService) AddProduct(ctx context.Context, req *pvzpb.AddProductRequest) (*pvzpb.Product, error) {
+	caller, pvzID, err := callerAndPVZ(ctx, req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	body := productRequestToAPI(req, pvzID)
+	if !handlers.ValidProductDetails(body) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid request format")
+	}
+
+	product, err := s.model.AddProduct(ctx, body, caller.UserID)
+	if errors.Is(err, data.ErrUnknownProductType) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid product type")
+	}
+	if errors.Is(err, data.ErrDuplicateBarcode) {
+		return nil, status.Error(codes.AlreadyExists, "product with this barcode is already in the reception")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.FailedPrecondition, "no reception in progress")
+	}
+	if err != nil {
+		return nil, s.internalError(ctx, "failed to add product", err)
+	}
+	return productToProto(handlers.TransformAddProductRowToProduct(product)), nil
+}
+
+func (s *pvzService) DeleteLastProduct(ctx context.Context, req *pvzpb.DeleteLastProductRequest) (*emptypb.Empty, error) {
+	caller, pvzID, err := callerAndPVZ(ctx, req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.model.DeleteLastProduct(ctx, pvzID, caller.UserID); err != nil {
+		s.logger.ErrorContext(ctx, "request failed", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, "Failed to delete product")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// callerAndPVZ returns the caller set by authInterceptor and the request's
+// PVZ ID, which the interceptor has already parsed once.
+func callerAndPVZ(ctx context.Context, pvzID string) (principal, openapi_types.UUID, error) {
+	caller, ok := principalFrom(ctx)
+	if !ok {
+		return principal{}, openapi_types.UUID{}, status.Error(codes.Unauthenticated, "invalid token claims")
+	}
+	id, err := uuid.Parse(pvzID)
+	if err != nil {
+		return principal{}, openapi_types.UUID{}, status.Error(codes.InvalidArgument, "invalid pvz_id")
+	}
+	return caller, id, nil
+}
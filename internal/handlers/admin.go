@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Действующая конфигурация сервера, секреты скрыты (право config:read)
+// (GET /admin/config)
+func (h *ServerHandler) GetAdminConfig(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, h.Config)
+}
@@ -1,102 +1,210 @@
 package handlers
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	_ "github.com/lib/pq"
 	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/keys"
+	"github.com/wisp167/pvz/internal/policy"
 )
 
 const (
-	RoleKey = "role"
+	RoleKey   = "role"
+	ClaimsKey = "claims"
+	UserIDKey = "user_id"
+	CityKey   = "city"
+
+	AccessTokenTTL = 15 * time.Minute
 )
 
+// TokenConfig holds the keys and registered claims used to issue and verify access tokens.
+type TokenConfig struct {
+	Keys      *keys.Manager
+	Issuer    string
+	Audience  string
+	ClockSkew time.Duration
+}
+
 type JWTConfig struct {
-	Skipper    func(c echo.Context) bool
-	SigningKey []byte
+	TokenConfig
+	Skipper func(c echo.Context) bool
+	// IsRevoked reports whether the access token with the given jti was revoked by logout.
+	IsRevoked func(ctx context.Context, jti uuid.UUID) (bool, error)
+	// Policy rejects tokens whose role has since been removed
+	Policy *policy.Engine
 }
 
 func AuthWithConfig(config JWTConfig) echo.MiddlewareFunc {
+	authenticate := NewAuthenticator(config)
+
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			if config.Skipper != nil && config.Skipper(c) {
 				return next(c)
 			}
 
-			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
-				return echo.NewHTTPError(http.StatusUnauthorized, "missing authorization header")
+			claims, userID, err := authenticate(c.Request().Context(), c.Request().Header.Get("Authorization"))
+			if err != nil {
+				return err
 			}
 
-			tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-			if tokenString == "" {
-				return echo.NewHTTPError(http.StatusUnauthorized, "invalid token format")
-			}
-
-			claims := &Claims{}
-			token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-				return config.SigningKey, nil
-			})
-			if err != nil || !token.Valid {
-				return echo.NewHTTPError(http.StatusUnauthorized, "invalid token")
-			}
-
-			if customClaims, ok := token.Claims.(*Claims); ok {
-				if customClaims.Role != "employee" && customClaims.Role != "moderator" {
-					return echo.NewHTTPError(http.StatusUnauthorized, "invalid role")
-				}
-				c.Set(RoleKey, customClaims.Role) // Now you can access the Role field
-			} else {
-				return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
-			}
+			c.Set(RoleKey, claims.Role)
+			c.Set(UserIDKey, userID)
+			c.Set(CityKey, claims.City)
+			c.Set(ClaimsKey, claims)
+			addLogAttrs(c, "user_id", userID.String(), "role", claims.Role)
 
 			return next(c)
 		}
 	}
 }
 
-func RoleRequired(requiredRole string) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
+// Authenticator checks a "Bearer <token>" header value and returns the
+// token's claims and user ID. Errors are *echo.HTTPError with status 401
+// (or 500 when the revocation check fails).
+type Authenticator func(ctx context.Context, authHeader string) (*Claims, uuid.UUID, error)
+
+// NewAuthenticator builds the token check shared by the REST middleware and
+// the gRPC interceptors; config.Skipper is not used.
+func NewAuthenticator(config JWTConfig) Authenticator {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(keys.Algorithms),
+		jwt.WithIssuer(config.Issuer),
+		jwt.WithAudience(config.Audience),
+		jwt.WithLeeway(config.ClockSkew),
+		jwt.WithIssuedAt(),
+		jwt.WithExpirationRequired(),
+	)
+
+	return func(ctx context.Context, authHeader string) (*Claims, uuid.UUID, error) {
+		if authHeader == "" {
+			return nil, uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "missing authorization header")
+		}
 
-			role, ok := c.Get(RoleKey).(string)
+		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+		if tokenString == "" {
+			return nil, uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid token format")
+		}
 
-			if !ok || role != requiredRole {
-				return echo.NewHTTPError(http.StatusForbidden, "Access denied")
+		claims := &Claims{}
+		token, err := parser.ParseWithClaims(tokenString, claims, verificationKey(config.Keys))
+		if err != nil || !token.Valid {
+			return nil, uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid token")
+		}
+		// WithIssuedAt only checks iat when the token has one
+		if claims.IssuedAt == nil {
+			return nil, uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+		}
+
+		if !config.Policy.HasRole(claims.Role) {
+			return nil, uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid role")
+		}
+		userID, err := uuid.Parse(claims.Subject)
+		if err != nil {
+			return nil, uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+		}
+		jti, err := uuid.Parse(claims.ID)
+		if err != nil {
+			return nil, uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+		}
+		if config.IsRevoked != nil {
+			revoked, err := config.IsRevoked(ctx, jti)
+			if err != nil {
+				return nil, uuid.Nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to check token")
+			}
+			if revoked {
+				return nil, uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "token revoked")
 			}
-			c.Set(RoleKey, nil)
-			return next(c)
 		}
+		return claims, userID, nil
 	}
 }
 
+// UserID returns the ID of the authenticated user taken from the token subject.
+func UserID(c echo.Context) (uuid.UUID, bool) {
+	userID, ok := c.Get(UserIDKey).(uuid.UUID)
+	return userID, ok
+}
+
 type Claims struct {
 	Role string `json:"role"`
-	jwt.StandardClaims
+	City string `json:"city,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Principal is the user an access token is issued to.
+type Principal struct {
+	UserID uuid.UUID
+	Role   string
+	City   string
 }
 
 type AuthResponse struct {
 	Token string `json:"token"`
 }
 
-func DummyLogin(role string, jwtkey []byte) (api.Token, error) {
-	expirationTime := time.Now().Add(time.Hour)
+// verificationKey looks the token's kid up in the key set and only accepts
+// the algorithm that key was issued for.
+func verificationKey(keySet *keys.Manager) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, errors.New("missing kid header")
+		}
+		key, ok := keySet.Lookup(kid)
+		if !ok {
+			return nil, keys.ErrKeyNotFound
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+		return key.PublicKey, nil
+	}
+}
+
+func DummyLogin(config TokenConfig, principal Principal) (api.Token, error) {
+	token, _, err := NewAccessToken(config, principal)
+	return token, err
+}
+
+// NewAccessToken signs a short-lived access token for principal with a unique jti so it can be revoked.
+func NewAccessToken(config TokenConfig, principal Principal) (api.Token, time.Time, error) {
+	key, err := config.Keys.Active()
+	if err != nil {
+		return api.Token(""), time.Time{}, err
+	}
+
+	now := time.Now()
+	expirationTime := now.Add(AccessTokenTTL)
 	claims := &Claims{
-		Role: role,
-		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: expirationTime.Unix(),
+		Role: principal.Role,
+		City: principal.City,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   principal.UserID.String(),
+			Issuer:    config.Issuer,
+			Audience:  jwt.ClaimStrings{config.Audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(jwtkey)
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	tokenString, err := token.SignedString(key.PrivateKey)
 	if err != nil {
-		return api.Token(""), err
+		return api.Token(""), time.Time{}, err
 	}
 
-	return api.Token(tokenString), nil
+	return api.Token(tokenString), expirationTime, nil
 }
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/helpers"
+)
+
+// Справочник городов
+// (GET /cities)
+func (h *ServerHandler) GetCities(ctx echo.Context) error {
+
+	reqCtx := ctx.Request().Context()
+
+	rows, err := h.Model.ListCities(reqCtx)
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list cities")
+	}
+
+	cities := make([]api.CatalogEntry, 0, len(rows))
+	for _, row := range rows {
+		cities = append(cities, api.CatalogEntry{Name: row.Name, Active: row.Active})
+	}
+	return ctx.JSON(http.StatusOK, cities)
+}
+
+// Добавление или (де)активация города (право catalog:manage)
+// (PUT /cities/{name})
+func (h *ServerHandler) PutCitiesName(ctx echo.Context, name string) error {
+	var req api.PutCitiesNameJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if !validCatalogName(name) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid city")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	city, err := h.Model.SaveCity(reqCtx, name, req.Active)
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to save city")
+	}
+	return ctx.JSON(http.StatusOK, api.CatalogEntry{Name: city.Name, Active: city.Active})
+}
+
+// Справочник типов товаров
+// (GET /product_types)
+func (h *ServerHandler) GetProductTypes(ctx echo.Context) error {
+
+	reqCtx := ctx.Request().Context()
+
+	rows, err := h.Model.ListProductTypes(reqCtx)
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list product types")
+	}
+
+	productTypes := make([]api.CatalogEntry, 0, len(rows))
+	for _, row := range rows {
+		productTypes = append(productTypes, api.CatalogEntry{Name: row.Name, Active: row.Active})
+	}
+	return ctx.JSON(http.StatusOK, productTypes)
+}
+
+// Добавление или (де)активация типа товара (право catalog:manage)
+// (PUT /product_types/{name})
+func (h *ServerHandler) PutProductTypesName(ctx echo.Context, name string) error {
+	var req api.PutProductTypesNameJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if !validCatalogName(name) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid product type")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	productType, err := h.Model.SaveProductType(reqCtx, name, req.Active)
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to save product type")
+	}
+	return ctx.JSON(http.StatusOK, api.CatalogEntry{Name: productType.Name, Active: productType.Active})
+}
+
+// names are stored as VARCHAR(50)
+func validCatalogName(name string) bool {
+	return name != "" && len([]rune(name)) <= 50
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/data"
+	"github.com/wisp167/pvz/internal/policy"
+)
+
+const (
+	// eventsReplayPage is how many missed events are read per query when a
+	// client resumes with Last-Event-ID
+	eventsReplayPage = 500
+	// eventsHeartbeat keeps idle streams from being closed by proxies
+	eventsHeartbeat = 15 * time.Second
+)
+
+// Поток событий ПВЗ
+// (GET /pvz/{pvzId}/events)
+func (h *ServerHandler) GetPvzPvzIdEvents(ctx echo.Context, pvzId openapi_types.UUID, params api.GetPvzPvzIdEventsParams) error {
+	return h.streamEvents(ctx, data.EventFilter{PvzID: uuid.UUID(pvzId)}, params.LastEventID)
+}
+
+// Поток событий всех ПВЗ города
+// (GET /cities/{name}/events)
+func (h *ServerHandler) GetCitiesNameEvents(ctx echo.Context, name string, params api.GetCitiesNameEventsParams) error {
+	// the path has no pvzId for Authorizer to check, and a grant on assigned
+	// PVZs does not cover a whole city
+	switch scope, _ := ctx.Get(ScopeKey).(policy.Scope); scope {
+	case policy.ScopeAll:
+	case policy.ScopeCity:
+		if userCity, _ := ctx.Get(CityKey).(string); userCity != name {
+			return echo.NewHTTPError(http.StatusForbidden, "Access denied: another city")
+		}
+	default:
+		return echo.NewHTTPError(http.StatusForbidden, "Access denied")
+	}
+	if !h.Model.Catalog.HasCity(name) {
+		return echo.NewHTTPError(http.StatusBadRequest, "unknown or inactive city")
+	}
+	return h.streamEvents(ctx, data.EventFilter{City: name}, params.LastEventID)
+}
+
+// streamEvents serves the events matching filter as text/event-stream until
+// the client goes away or the server shuts down. With lastEventID the events
+// committed after it are sent first.
+func (h *ServerHandler) streamEvents(ctx echo.Context, filter data.EventFilter, lastEventID *int64) error {
+	if h.Stream == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "event stream is not available")
+	}
+
+	// subscribe before reading the backlog, so nothing committed in between is lost
+	sub := h.Stream.Subscribe(filter)
+	defer h.Stream.Unsubscribe(sub)
+
+	reqCtx := ctx.Request().Context()
+	w := ctx.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	// nginx would otherwise buffer the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	// Event ids follow commit order, so everything up to the last id sent has
+	// been sent; live events the replay already covered are skipped.
+	var lastID int64
+	if lastEventID != nil {
+		lastID = *lastEventID
+		for {
+			events, err := h.Model.EventsSince(reqCtx, filter, lastID, eventsReplayPage)
+			if err != nil {
+				// the status is sent already, the client reconnects and retries
+				h.logError(ctx, err)
+				return nil
+			}
+			for _, row := range events {
+				if err := writeEvent(w, ConvertPvzEventToAPI(row)); err != nil {
+					return nil
+				}
+				lastID = row.ID
+			}
+			if len(events) < eventsReplayPage {
+				break
+			}
+		}
+	}
+
+	heartbeat := time.NewTicker(eventsHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-reqCtx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				// dropped or shutting down; the client resumes with Last-Event-ID
+				return nil
+			}
+			if event.Id <= lastID {
+				continue
+			}
+			if err := writeEvent(w, event); err != nil {
+				return nil
+			}
+			lastID = event.Id
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			w.Flush()
+		}
+	}
+}
+
+func writeEvent(w *echo.Response, event api.PVZEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, payload); err != nil {
+		return err
+	}
+	w.Flush()
+	return nil
+}
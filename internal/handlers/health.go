@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wisp167/pvz/api"
+)
+
+// Процесс жив (liveness)
+// (GET /healthz)
+func (h *ServerHandler) GetHealthz(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, api.Health{Status: api.Ok})
+}
+
+// Готовность принимать трафик (БД, подготовленные запросы, миграции)
+// (GET /readyz)
+func (h *ServerHandler) GetReadyz(ctx echo.Context) error {
+	checks := map[string]string{"server": "ok"}
+	status := api.Ok
+
+	if h.Ready != nil && !h.Ready() {
+		checks["server"] = "shutting down"
+		status = api.Unavailable
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx.Request().Context(), 2*time.Second)
+	defer cancel()
+
+	for name, err := range h.Model.Health(reqCtx) {
+		checks[name] = "ok"
+		if err != nil {
+			checks[name] = err.Error()
+			status = api.Unavailable
+		}
+	}
+
+	code := http.StatusOK
+	if status != api.Ok {
+		code = http.StatusServiceUnavailable
+	}
+	return ctx.JSON(code, api.Health{Status: status, Checks: &checks})
+}
+
+// Версия сборки
+// (GET /version)
+func (h *ServerHandler) GetVersion(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, h.Version)
+}
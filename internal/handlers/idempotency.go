@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wisp167/pvz/internal/data"
+)
+
+const (
+	HeaderIdempotencyKey       = "Idempotency-Key"
+	HeaderIdempotentReplayed   = "Idempotent-Replayed"
+	maxIdempotencyKeyLength    = 255
+	idempotencyCompleteTimeout = 5 * time.Second
+)
+
+// Idempotent lets clients retry a POST safely: the first response sent for an
+// Idempotency-Key is stored for Config.IdempotencyTTL and replayed to later
+// requests of the same user with the same key. Reusing the key for another
+// method, path or body is answered with 422, and a retry that arrives while
+// the first request is still running with 409. Server errors are not stored,
+// so the request can be retried with the same key. Requests without the
+// header, or without an authenticated user, are passed through.
+//
+// Routes open to anonymous clients use rejectIdempotencyKey instead.
+func (h *ServerHandler) Idempotent(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(HeaderIdempotencyKey)
+		if key == "" {
+			return next(c)
+		}
+		userID, ok := UserID(c)
+		if !ok {
+			return next(c)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return echo.NewHTTPError(http.StatusBadRequest, "Idempotency-Key is too long")
+		}
+
+		hash, err := requestHash(c)
+		if err != nil {
+			return err
+		}
+		addLogAttrs(c, "idempotency_key", key)
+
+		stored, err := h.Model.BeginIdempotentRequest(c.Request().Context(), userID, key, hash, h.Config.IdempotencyTTL)
+		if errors.Is(err, data.ErrIdempotencyKeyReused) {
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, "Idempotency-Key was already used for a different request")
+		}
+		if errors.Is(err, data.ErrIdempotencyKeyInProgress) {
+			return echo.NewHTTPError(http.StatusConflict, "a request with this Idempotency-Key is still in progress")
+		}
+		if err != nil {
+			h.logError(c, err)
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check Idempotency-Key")
+		}
+		if stored != nil {
+			c.Response().Header().Set(HeaderIdempotentReplayed, "true")
+			if stored.ContentType != "" {
+				c.Response().Header().Set(echo.HeaderContentType, stored.ContentType)
+			}
+			c.Response().WriteHeader(stored.StatusCode)
+			_, err := c.Response().Write(stored.Body)
+			return err
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+		err = next(c)
+		if err != nil {
+			// render the error now, so it is recorded like any other response
+			c.Error(err)
+		}
+		c.Response().Writer = recorder.ResponseWriter
+
+		// the client may be gone by now, which is exactly when it will retry
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request().Context()), idempotencyCompleteTimeout)
+		defer cancel()
+
+		status := c.Response().Status
+		if status >= http.StatusInternalServerError || !c.Response().Committed {
+			if err := h.Model.ReleaseIdempotentRequest(ctx, userID, key); err != nil {
+				h.logger.ErrorContext(ctx, "failed to release idempotency key", "error", err)
+			}
+			return nil
+		}
+		err = h.Model.CompleteIdempotentRequest(ctx, userID, key, data.IdempotentResponse{
+			StatusCode:  status,
+			ContentType: c.Response().Header().Get(echo.HeaderContentType),
+			Body:        recorder.body.Bytes(),
+		})
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to store idempotent response", "error", err)
+		}
+		return nil
+	}
+}
+
+// rejectIdempotencyKey answers 400 to requests sent with an Idempotency-Key
+// on routes without a user to keep keys for. Their responses carry tokens,
+// which are not stored for replay, and dropping the header silently would
+// let the client believe its retries are safe.
+func rejectIdempotencyKey(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Request().Header.Get(HeaderIdempotencyKey) != "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "Idempotency-Key is not supported on this endpoint")
+		}
+		return next(c)
+	}
+}
+
+// requestHash identifies a request by method, path and body, leaving the
+// body in place for the handler.
+func requestHash(c echo.Context) ([]byte, error) {
+	req := c.Request()
+	sum := sha256.New()
+	io.WriteString(sum, req.Method+" "+req.URL.Path+"\n")
+
+	if req.Body != nil {
+		body, err := io.ReadAll(http.MaxBytesReader(c.Response(), req.Body, 1_048_576))
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusRequestEntityTooLarge, "body too large")
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		sum.Write(body)
+	}
+	return sum.Sum(nil), nil
+}
+
+// responseRecorder keeps a copy of the response body written through it.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
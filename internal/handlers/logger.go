@@ -1,27 +1,68 @@
 package handlers
 
 import (
-	"runtime"
-	"strings"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/wisp167/pvz/internal/logging"
 )
 
-func (h *ServerHandler) logError(err error) {
-	_, file, line, ok := runtime.Caller(2)
-	if !ok {
-		file = "unknown"
-		line = 0
-	}
-	_, file1, line1, ok1 := runtime.Caller(3)
-	if !ok1 {
-		file1 = "unknown"
-		line = 0
+// RequestLogger gives every request an ID, taken from X-Request-ID when the
+// caller sends one, echoes it back and logs one line per request. It runs
+// before auth, so the request ID and route are in the context of every
+// later log line; auth adds the user and role.
+func RequestLogger(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			requestID := c.Request().Header.Get(echo.HeaderXRequestID)
+			if requestID == "" || len(requestID) > 128 {
+				requestID = uuid.NewString()
+			}
+			c.Response().Header().Set(echo.HeaderXRequestID, requestID)
+
+			args := []any{"request_id", requestID, "route", c.Path()}
+			if pvzID := c.Param("pvzId"); pvzID != "" {
+				args = append(args, "pvz_id", pvzID)
+			}
+			addLogAttrs(c, args...)
+
+			err := next(c)
+
+			status := c.Response().Status
+			var he *echo.HTTPError
+			if errors.As(err, &he) {
+				status = he.Code
+			} else if err != nil {
+				status = http.StatusInternalServerError
+			}
+
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			logger.Log(c.Request().Context(), level, "request",
+				"method", c.Request().Method,
+				"path", c.Request().URL.Path,
+				"status", status,
+				"duration", time.Since(start),
+			)
+			return err
+		}
 	}
+}
+
+// addLogAttrs adds args to the request context, so every line logged for
+// this request from here on carries them.
+func addLogAttrs(c echo.Context, args ...any) {
+	c.SetRequest(c.Request().WithContext(logging.With(c.Request().Context(), args...)))
+}
 
-	// Extract just the filename from the full path
-	fileParts := strings.Split(file, "/")
-	filename := fileParts[len(fileParts)-1]
-	fileParts = strings.Split(file1, "/")
-	filename1 := fileParts[len(fileParts)-1]
-	// Log the error with the file and line number
-	h.logger.Printf("[%s:%d]->[%s:%d] %v", filename, line, filename1, line1, err)
+func (h *ServerHandler) logError(ctx echo.Context, err error) {
+	h.logger.ErrorContext(ctx.Request().Context(), "request failed", "error", err)
 }
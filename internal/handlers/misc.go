@@ -1,14 +1,14 @@
 package handlers
 
 import (
-	"fmt"
-	"strings"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/data"
 	"github.com/wisp167/pvz/internal/db"
 )
 
@@ -28,14 +28,71 @@ func TransformAddProductRowToProduct(row db.AddProductRow) api.Product {
 		DateTime:    dateTimePtr,
 		Id:          idPtr,
 		ReceptionId: types.UUID(row.ReceptionID),
-		Type:        api.ProductType(row.Type),
+		Type:        row.Type,
+		CreatedBy:   nullUUIDToAPI(row.CreatedBy),
+		Barcode:     nullStringToAPI(row.Barcode),
+		Sku:         nullStringToAPI(row.Sku),
+		WeightGrams: nullInt32ToAPI(row.WeightGrams),
+		Dimensions:  dimensionsToAPI(row.LengthMm, row.WidthMm, row.HeightMm),
 	}
 }
 
+func TransformAddProductsRowToProduct(row db.AddProductsRow) api.Product {
+	var dateTimePtr *time.Time
+	if row.DateTime.Valid {
+		dateTimePtr = &row.DateTime.Time
+	}
+
+	id := types.UUID(row.ID)
+	return api.Product{
+		DateTime:    dateTimePtr,
+		Id:          &id,
+		ReceptionId: types.UUID(row.ReceptionID),
+		Type:        row.Type,
+		CreatedBy:   nullUUIDToAPI(row.CreatedBy),
+		Barcode:     nullStringToAPI(row.Barcode),
+		Sku:         nullStringToAPI(row.Sku),
+		WeightGrams: nullInt32ToAPI(row.WeightGrams),
+		Dimensions:  dimensionsToAPI(row.LengthMm, row.WidthMm, row.HeightMm),
+	}
+}
+
+func nullUUIDToAPI(id uuid.NullUUID) *openapi_types.UUID {
+	if !id.Valid {
+		return nil
+	}
+	apiID := openapi_types.UUID(id.UUID)
+	return &apiID
+}
+
+func nullStringToAPI(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+func nullInt32ToAPI(n sql.NullInt32) *int {
+	if !n.Valid {
+		return nil
+	}
+	v := int(n.Int32)
+	return &v
+}
+
+func dimensionsToAPI(length, width, height sql.NullInt32) *api.Dimensions {
+	if !length.Valid || !width.Valid || !height.Valid {
+		return nil
+	}
+	return &api.Dimensions{LengthMm: int(length.Int32), WidthMm: int(width.Int32), HeightMm: int(height.Int32)}
+}
+
 func ConvertReceptionRowToAPI(row db.CreateOrGetReceptionRow) api.Reception {
 	reception := api.Reception{
-		PvzId:  openapi_types.UUID(row.PvzID),
-		Status: api.ReceptionStatus(row.Status),
+		PvzId:     openapi_types.UUID(row.PvzID),
+		Status:    api.ReceptionStatus(row.Status),
+		CreatedBy: nullUUIDToAPI(row.CreatedBy),
+		ClosedBy:  nullUUIDToAPI(row.ClosedBy),
 	}
 
 	// Handle ID (convert to pointer)
@@ -56,10 +113,11 @@ func ConvertReceptionRowToAPI(row db.CreateOrGetReceptionRow) api.Reception {
 }
 
 func ConvertCloseReceptionRowToAPI(row db.CloseReceptionRow) api.Reception {
-	fmt.Printf("DEBUG: Received row: %+v\n", row)
 	reception := api.Reception{
-		PvzId:  openapi_types.UUID(row.PvzID),
-		Status: api.ReceptionStatus(row.Status),
+		PvzId:     openapi_types.UUID(row.PvzID),
+		Status:    api.ReceptionStatus(row.Status),
+		CreatedBy: nullUUIDToAPI(row.CreatedBy),
+		ClosedBy:  nullUUIDToAPI(row.ClosedBy),
 	}
 
 	// Handle ID (convert to pointer)
@@ -79,9 +137,40 @@ func ConvertCloseReceptionRowToAPI(row db.CloseReceptionRow) api.Reception {
 	return reception
 }
 
+// ConvertReceptionStatusRowToAPI converts the reception returned by a
+// status transition; the reception row types differ only in name.
+func ConvertReceptionStatusRowToAPI(row db.SetReceptionStatusRow) api.Reception {
+	return ConvertCloseReceptionRowToAPI(db.CloseReceptionRow(row))
+}
+
+func ConvertReceptionTransitionToAPI(row db.ReceptionTransition) api.ReceptionTransition {
+	return api.ReceptionTransition{
+		Id:         row.ID,
+		FromStatus: row.FromStatus,
+		ToStatus:   row.ToStatus,
+		Reason:     nullStringToAPI(row.Reason),
+		ActorId:    nullUUIDToAPI(row.ActorID),
+		CreatedAt:  row.CreatedAt,
+	}
+}
+
+func ConvertPvzEventToAPI(row db.PvzEvent) api.PVZEvent {
+	return api.PVZEvent{
+		Id:          row.ID,
+		Type:        api.PVZEventType(row.Type),
+		PvzId:       types.UUID(row.PvzID),
+		City:        row.City,
+		ReceptionId: types.UUID(row.ReceptionID),
+		ProductId:   nullUUIDToAPI(row.ProductID),
+		ProductType: nullStringToAPI(row.ProductType),
+		ActorId:     nullUUIDToAPI(row.ActorID),
+		CreatedAt:   row.CreatedAt,
+	}
+}
+
 func ConvertCreatePVZRowToPVZ(row db.CreatePVZRow) api.PVZ {
 	pvz := api.PVZ{
-		City: api.PVZCity(row.City),
+		City: row.City,
 	}
 
 	uuidVal := types.UUID(row.ID)
@@ -93,31 +182,95 @@ func ConvertCreatePVZRowToPVZ(row db.CreatePVZRow) api.PVZ {
 
 	return pvz
 }
-func ToUser(row *db.CreateUserRow) (*api.User, error) {
-	// Convert UUID
-	var userID *openapi_types.UUID
+func ToUser(row db.CreateUserRow) api.User {
+	user := api.User{
+		Email: openapi_types.Email(row.Email),
+		Role:  row.Role,
+	}
 	if row.ID != uuid.Nil {
-		parsedUUID := openapi_types.UUID(row.ID)
-		userID = &parsedUUID
+		id := openapi_types.UUID(row.ID)
+		user.Id = &id
+	}
+	if row.City.Valid {
+		user.City = &row.City.String
+	}
+	return user
+}
+
+func ConvertRoleToAPI(role data.Role) api.Role {
+	resp := api.Role{
+		Name:        role.Name,
+		Description: &role.Description,
+		Permissions: make([]api.RolePermission, 0, len(role.Grants)),
+	}
+	for _, grant := range role.Grants {
+		resp.Permissions = append(resp.Permissions, api.RolePermission{
+			Permission: grant.Permission,
+			Scope:      api.RolePermissionScope(grant.Scope),
+		})
+	}
+	return resp
+}
+
+func ConvertPvzStaffToAPI(row db.PvzStaff) api.StaffAssignment {
+	staff := api.StaffAssignment{
+		PvzId:      openapi_types.UUID(row.PvzID),
+		UserId:     openapi_types.UUID(row.UserID),
+		AssignedBy: nullUUIDToAPI(row.AssignedBy),
+	}
+	if row.AssignedAt.Valid {
+		staff.AssignedAt = &row.AssignedAt.Time
 	}
+	return staff
+}
 
-	// Convert email
+func ConvertListStaffRowToAPI(row db.ListStaffRow) api.StaffAssignment {
 	email := openapi_types.Email(row.Email)
+	staff := api.StaffAssignment{
+		PvzId:      openapi_types.UUID(row.PvzID),
+		UserId:     openapi_types.UUID(row.UserID),
+		Email:      &email,
+		AssignedBy: nullUUIDToAPI(row.AssignedBy),
+	}
+	if row.AssignedAt.Valid {
+		staff.AssignedAt = &row.AssignedAt.Time
+	}
+	return staff
+}
+
+func ConvertReceptionProductToAPI(row db.ListReceptionProductsRow) api.Product {
+	id := openapi_types.UUID(row.ID)
+	product := api.Product{
+		Id:          &id,
+		ReceptionId: openapi_types.UUID(row.ReceptionID),
+		Type:        row.Type,
+		CreatedBy:   nullUUIDToAPI(row.CreatedBy),
+		DeletedBy:   nullUUIDToAPI(row.DeletedBy),
+		Barcode:     nullStringToAPI(row.Barcode),
+		Sku:         nullStringToAPI(row.Sku),
+		WeightGrams: nullInt32ToAPI(row.WeightGrams),
+		Dimensions:  dimensionsToAPI(row.LengthMm, row.WidthMm, row.HeightMm),
+	}
+	if row.DateTime.Valid {
+		product.DateTime = &row.DateTime.Time
+	}
+	if row.DeletedAt.Valid {
+		product.DeletedAt = &row.DeletedAt.Time
+	}
+	return product
+}
+
+func ConvertReceptionDetailToAPI(detail data.ReceptionDetail) api.ReceptionWithProducts {
+	resp := api.ReceptionWithProducts{
+		Reception: ConvertReceptionRowToAPI(db.CreateOrGetReceptionRow(detail.Reception)),
+		Products:  make([]api.Product, 0, len(detail.Products)),
+	}
+	for _, row := range detail.Products {
+		resp.Products = append(resp.Products, ConvertReceptionProductToAPI(row))
+	}
+	return resp
+}
 
-	// Convert role
-	var userRole api.UserRole
-	switch strings.ToLower(row.Role) {
-	case "employee":
-		userRole = api.UserRoleEmployee
-	case "moderator":
-		userRole = api.UserRoleModerator
-	default:
-		return nil, fmt.Errorf("invalid role: %s", row.Role)
-	}
-
-	return &api.User{
-		Id:    userID,
-		Email: email,
-		Role:  userRole,
-	}, nil
+func stringPtr(s string) *string {
+	return &s
 }
@@ -3,51 +3,84 @@ package handlers
 import (
 	"database/sql"
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/config"
 	"github.com/wisp167/pvz/internal/data"
+	"github.com/wisp167/pvz/internal/db"
 	"github.com/wisp167/pvz/internal/helpers"
+	"github.com/wisp167/pvz/internal/policy"
+	"github.com/wisp167/pvz/internal/stream"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/wisp167/pvz/internal/handlers")
+
 type ServerHandler struct {
 	Model  *data.Models
-	jwtkey []byte
-	logger *log.Logger
+	Config *config.Config
+	// Ready reports whether the server is started and not shutting down
+	Ready   func() bool
+	Version api.Version
+	// Stream delivers the PVZ events served by the /events routes
+	Stream *stream.Broker
+	tokens TokenConfig
+	logger *slog.Logger
 }
 
-func (h *ServerHandler) InitUnexportedVals(jwtkey []byte, logger *log.Logger) {
-	h.jwtkey = jwtkey
+func (h *ServerHandler) InitUnexportedVals(tokens TokenConfig, logger *slog.Logger) {
+	h.tokens = tokens
 	h.logger = logger
 }
 
+// Публичные ключи для проверки JWT (JWKS)
+// (GET /.well-known/jwks.json)
+func (h *ServerHandler) GetWellKnownJwksJson(ctx echo.Context) error {
+	var resp api.JWKS
+	resp.Keys = []api.JWK{}
+	for _, key := range h.tokens.Keys.JWKS() {
+		jwk := api.JWK{Kty: key.Kty, Kid: key.Kid, Use: key.Use, Alg: key.Alg}
+		if key.N != "" {
+			jwk.N, jwk.E = &key.N, &key.E
+		}
+		if key.X != "" {
+			jwk.Crv, jwk.X = &key.Crv, &key.X
+		}
+		resp.Keys = append(resp.Keys, jwk)
+	}
+	ctx.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return ctx.JSON(http.StatusOK, resp)
+}
+
 // Получение тестового токена
 // (POST /dummyLogin)
 func (h *ServerHandler) PostDummyLogin(ctx echo.Context) error {
 	var req api.PostDummyLoginJSONBody
 	if err := helpers.ReadJSON(ctx, &req); err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
 	}
 
-	switch req.Role {
-	case api.PostDummyLoginJSONBodyRoleEmployee, api.PostDummyLoginJSONBodyRoleModerator:
-		token, err := DummyLogin(string(req.Role), h.jwtkey)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate token")
-		}
-		return ctx.JSON(http.StatusOK, token)
+	// privileged roles are only handed out through POST /users, as with /register
+	if req.Role != api.PostDummyLoginJSONBodyRoleEmployee && req.Role != api.PostDummyLoginJSONBodyRoleModerator {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid role")
 	}
-	/*
-		token, err := DummyLogin(string(req.Role), h.jwtkey)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate token")
-		}
-	*/
-	return echo.NewHTTPError(http.StatusBadRequest, "Invalid role")
+
+	user, err := h.Model.DummyUser(ctx.Request().Context(), string(req.Role))
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate token")
+	}
+	token, err := DummyLogin(h.tokens, Principal{UserID: user.ID, Role: user.Role, City: user.City.String})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate token")
+	}
+	return ctx.JSON(http.StatusOK, token)
 }
 
 // Авторизация пользователя
@@ -55,53 +88,139 @@ func (h *ServerHandler) PostDummyLogin(ctx echo.Context) error {
 func (h *ServerHandler) PostLogin(ctx echo.Context) error {
 	var req api.PostLoginJSONBody
 	if err := helpers.ReadJSON(ctx, &req); err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
 	}
 	reqCtx := ctx.Request().Context()
 
-	role, err := h.Model.Login(reqCtx, req)
+	user, err := h.Model.Login(reqCtx, req)
 
 	if err != nil {
-		if err == data.ErrRecordNotFound {
+		if errors.Is(err, data.ErrInvalidCredentials) {
 			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid credentials")
 		}
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusUnauthorized, "Failed to authenticate")
 	}
 
 	// Generate JWT token
-	token, err := DummyLogin(role, h.jwtkey)
+	token, expiresAt, err := NewAccessToken(h.tokens, Principal{UserID: user.ID, Role: user.Role, City: user.City.String})
 	if err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate token")
 	}
 
-	return ctx.JSON(http.StatusOK, token)
+	refreshToken, err := h.Model.IssueRefreshToken(reqCtx, user.ID)
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate token")
+	}
+
+	return ctx.JSON(http.StatusOK, api.TokenPair{Token: token, RefreshToken: refreshToken, ExpiresAt: expiresAt})
+}
+
+// Обновление токена доступа по refresh-токену
+// (POST /token/refresh)
+func (h *ServerHandler) PostTokenRefresh(ctx echo.Context) error {
+	var req api.PostTokenRefreshJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	reqCtx := ctx.Request().Context()
+
+	user, refreshToken, err := h.Model.RotateRefreshToken(reqCtx, req.RefreshToken)
+	if errors.Is(err, data.ErrInvalidRefreshToken) || errors.Is(err, data.ErrRefreshTokenReused) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Invalid refresh token")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to refresh token")
+	}
+
+	token, expiresAt, err := NewAccessToken(h.tokens, Principal{UserID: user.ID, Role: user.Role, City: user.City.String})
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate token")
+	}
+
+	return ctx.JSON(http.StatusOK, api.TokenPair{Token: token, RefreshToken: refreshToken, ExpiresAt: expiresAt})
+}
+
+// Выход из системы с отзывом токена доступа и refresh-токена
+// (POST /logout)
+func (h *ServerHandler) PostLogout(ctx echo.Context) error {
+	var req api.PostLogoutJSONBody
+	if err := helpers.ReadOptionalJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+
+	claims, ok := ctx.Get(ClaimsKey).(*Claims)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+	jti, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+
+	var refreshToken string
+	if req.RefreshToken != nil {
+		refreshToken = *req.RefreshToken
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+
+	err = h.Model.Logout(reqCtx, userID, jti, claims.ExpiresAt.Time, refreshToken)
+	if errors.Is(err, data.ErrInvalidRefreshToken) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid refresh token")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to logout")
+	}
+	return ctx.NoContent(http.StatusNoContent)
 }
 
 // Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 // (POST /products)
 func (h *ServerHandler) PostProducts(ctx echo.Context) error {
-	//app.queue <- struct{}{}
-	//defer func() {
-	//	<-app.queue
-	//}()
-
 	var req api.PostProductsJSONBody
 	if err := helpers.ReadJSON(ctx, &req); err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
 	}
+	addLogAttrs(ctx, "pvz_id", req.PvzId.String())
+
+	if !ValidProductDetails(req) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
 
 	reqCtx := ctx.Request().Context()
 
-	product, err := h.Model.AddProduct(reqCtx, req)
+	product, err := h.Model.AddProduct(reqCtx, req, userID)
+	if errors.Is(err, data.ErrUnknownProductType) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid product type")
+	}
+	if errors.Is(err, data.ErrDuplicateBarcode) {
+		return echo.NewHTTPError(http.StatusConflict, "product with this barcode is already in the reception")
+	}
 	if errors.Is(err, sql.ErrNoRows) {
 		return echo.NewHTTPError(http.StatusBadRequest, "product already exists")
 	}
 	if err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Failed to register product")
 	}
 	return ctx.JSON(http.StatusCreated, TransformAddProductRowToProduct(product))
@@ -113,50 +232,159 @@ func (h *ServerHandler) GetPvz(ctx echo.Context, params api.GetPvzParams) error
 
 	reqCtx := ctx.Request().Context()
 
-	pvz, err := h.Model.GetPVZ(reqCtx, params)
+	// city-scoped readers only see the PVZs of their own city
+	var city string
+	if scope, _ := ctx.Get(ScopeKey).(policy.Scope); scope == policy.ScopeCity {
+		city, _ = ctx.Get(CityKey).(string)
+	}
+
+	pvz, err := h.Model.GetPVZ(reqCtx, params, city)
 	if err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to register user")
 	}
+
+	_, span := tracer.Start(reqCtx, "GetPvz.encode")
+	defer span.End()
 	return ctx.JSON(http.StatusOK, pvz)
 
 }
 
-// Создание ПВЗ (только для модераторов)
+// Создание ПВЗ (право pvz:create)
 // (POST /pvz)
 func (h *ServerHandler) PostPvz(ctx echo.Context) error {
 	var req api.PVZ
 	if err := helpers.ReadJSON(ctx, &req); err != nil {
-		h.logError(err)
-		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
-	}
-
-	if req.City != "Москва" && req.City != "Санкт-Петербург" && req.City != "Казань" {
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
 	}
 
 	reqCtx := ctx.Request().Context()
 
 	pvz, err := h.Model.AddPVZ(reqCtx, req)
+	if errors.Is(err, data.ErrUnknownCity) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid city")
+	}
 	if errors.Is(err, sql.ErrNoRows) {
 		return echo.NewHTTPError(http.StatusBadRequest, "user already exists")
 	}
 	if err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to register user")
 	}
 	return ctx.JSON(http.StatusCreated, ConvertCreatePVZRowToPVZ(pvz))
 }
 
+// ValidProductDetails checks the optional manifest fields against the limits in the spec.
+func ValidProductDetails(req api.PostProductsJSONBody) bool {
+	if req.Barcode != nil && (*req.Barcode == "" || len(*req.Barcode) > 64) {
+		return false
+	}
+	if req.Sku != nil && (*req.Sku == "" || len(*req.Sku) > 64) {
+		return false
+	}
+	if req.WeightGrams != nil && *req.WeightGrams < 1 {
+		return false
+	}
+	if d := req.Dimensions; d != nil && (d.LengthMm < 1 || d.WidthMm < 1 || d.HeightMm < 1) {
+		return false
+	}
+	return true
+}
+
+// Получение ПВЗ с приемками и товарами
+// (GET /pvz/{pvzId})
+func (h *ServerHandler) GetPvzPvzId(ctx echo.Context, pvzId openapi_types.UUID, params api.GetPvzPvzIdParams) error {
+
+	reqCtx := ctx.Request().Context()
+
+	includeDeleted := params.IncludeDeleted != nil && *params.IncludeDeleted
+
+	pvz, err := h.Model.GetPVZByID(reqCtx, pvzId, includeDeleted)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "pvz not found")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get pvz")
+	}
+	return ctx.JSON(http.StatusOK, pvz)
+}
+
+// Изменение ПВЗ (право pvz:update)
+// (PATCH /pvz/{pvzId})
+func (h *ServerHandler) PatchPvzPvzId(ctx echo.Context, pvzId openapi_types.UUID) error {
+	var req api.PatchPvzPvzIdJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if req.City == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+
+	// a city-scoped manager must not move the PVZ out of their city
+	if scope, _ := ctx.Get(ScopeKey).(policy.Scope); scope == policy.ScopeCity {
+		if city, _ := ctx.Get(CityKey).(string); city != *req.City {
+			return echo.NewHTTPError(http.StatusForbidden, "Access denied: pvz is in another city")
+		}
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	pvz, err := h.Model.UpdatePVZ(reqCtx, pvzId, *req.City)
+	if errors.Is(err, data.ErrUnknownCity) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid city")
+	}
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "pvz not found")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update pvz")
+	}
+	return ctx.JSON(http.StatusOK, ConvertCreatePVZRowToPVZ(db.CreatePVZRow(pvz)))
+}
+
+// Деактивация ПВЗ (право pvz:delete)
+// (DELETE /pvz/{pvzId})
+func (h *ServerHandler) DeletePvzPvzId(ctx echo.Context, pvzId openapi_types.UUID) error {
+
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	err := h.Model.DeactivatePVZ(reqCtx, pvzId, userID)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "pvz not found")
+	}
+	if errors.Is(err, data.ErrPVZHasOpenReception) {
+		return echo.NewHTTPError(http.StatusConflict, "pvz has a reception in progress")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to deactivate pvz")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
 // Закрытие последней открытой приемки товаров в рамках ПВЗ
 // (POST /pvz/{pvzId}/close_last_reception)
 func (h *ServerHandler) PostPvzPvzIdCloseLastReception(ctx echo.Context, pvzId openapi_types.UUID) error {
 
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+
 	reqCtx := ctx.Request().Context()
 
-	recep, err := h.Model.CloseLastReception(reqCtx, pvzId)
+	recep, err := h.Model.CloseLastReception(reqCtx, pvzId, userID)
 	if err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to register user")
 	}
 	return ctx.JSON(http.StatusOK, ConvertCloseReceptionRowToAPI(recep))
@@ -166,11 +394,16 @@ func (h *ServerHandler) PostPvzPvzIdCloseLastReception(ctx echo.Context, pvzId o
 // (POST /pvz/{pvzId}/delete_last_product)
 func (h *ServerHandler) PostPvzPvzIdDeleteLastProduct(ctx echo.Context, pvzId openapi_types.UUID) error {
 
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+
 	reqCtx := ctx.Request().Context()
 
-	err := h.Model.DeleteLastProduct(reqCtx, pvzId)
+	err := h.Model.DeleteLastProduct(reqCtx, pvzId, userID)
 	if err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Failed to delete product")
 	}
 	return ctx.JSON(http.StatusOK, nil)
@@ -181,15 +414,21 @@ func (h *ServerHandler) PostPvzPvzIdDeleteLastProduct(ctx echo.Context, pvzId op
 func (h *ServerHandler) PostReceptions(ctx echo.Context) error {
 	var req api.PostReceptionsJSONBody
 	if err := helpers.ReadJSON(ctx, &req); err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
 	}
+	addLogAttrs(ctx, "pvz_id", req.PvzId.String())
+
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
 
 	reqCtx := ctx.Request().Context()
 
-	recep, err := h.Model.AddReception(reqCtx, req)
+	recep, err := h.Model.AddReception(reqCtx, req, userID)
 	if err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Failed to register reception")
 	}
 	return ctx.JSON(http.StatusCreated, ConvertReceptionRowToAPI(recep))
@@ -198,17 +437,17 @@ func (h *ServerHandler) PostReceptions(ctx echo.Context) error {
 // Регистрация пользователя
 // (POST /register)
 func (h *ServerHandler) PostRegister(ctx echo.Context) error {
-	//app.queue <- struct{}{}
-	//defer func() {
-	//	<-app.queue
-	//}()
-
 	var req api.PostRegisterJSONBody
 	if err := helpers.ReadJSON(ctx, &req); err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
 	}
 
+	// privileged roles are only handed out through POST /users
+	if req.Role != api.PostRegisterJSONBodyRoleEmployee && req.Role != api.PostRegisterJSONBodyRoleModerator {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid role")
+	}
+
 	reqCtx := ctx.Request().Context()
 
 	user, err := h.Model.Register(reqCtx, req)
@@ -216,11 +455,9 @@ func (h *ServerHandler) PostRegister(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "user already exists")
 	}
 	if err != nil {
-		h.logError(err)
+		h.logError(ctx, err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Failed to register user")
 	}
 
-	resp, err := ToUser(&user)
-
-	return ctx.JSON(http.StatusCreated, resp)
+	return ctx.JSON(http.StatusCreated, ToUser(user))
 }
@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/data"
+	"github.com/wisp167/pvz/internal/db"
+	"github.com/wisp167/pvz/internal/helpers"
+	"github.com/wisp167/pvz/internal/policy"
+)
+
+// Получение приемки с товарами
+// (GET /receptions/{receptionId})
+func (h *ServerHandler) GetReceptionsReceptionId(ctx echo.Context, receptionId openapi_types.UUID, params api.GetReceptionsReceptionIdParams) error {
+	if err := h.authorizeReception(ctx, receptionId); err != nil {
+		return err
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	includeDeleted := params.IncludeDeleted != nil && *params.IncludeDeleted
+
+	detail, err := h.Model.GetReception(reqCtx, receptionId, includeDeleted)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "reception not found")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get reception")
+	}
+
+	return ctx.JSON(http.StatusOK, ConvertReceptionDetailToAPI(detail))
+}
+
+// История приемок ПВЗ с фильтрацией и курсорной пагинацией
+// (GET /pvz/{pvzId}/receptions)
+func (h *ServerHandler) GetPvzPvzIdReceptions(ctx echo.Context, pvzId openapi_types.UUID, params api.GetPvzPvzIdReceptionsParams) error {
+	if params.Limit != nil && (*params.Limit < 1 || *params.Limit > 100) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid limit")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	rows, next, err := h.Model.ListReceptions(reqCtx, pvzId, params)
+	if errors.Is(err, data.ErrInvalidCursor) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid cursor")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list receptions")
+	}
+
+	page := api.ReceptionPage{Items: make([]api.Reception, 0, len(rows))}
+	for _, row := range rows {
+		page.Items = append(page.Items, ConvertReceptionRowToAPI(db.CreateOrGetReceptionRow(row)))
+	}
+	if next != "" {
+		page.NextCursor = &next
+	}
+	return ctx.JSON(http.StatusOK, page)
+}
+
+// Текущая открытая приемка ПВЗ с товарами
+// (GET /pvz/{pvzId}/receptions/current)
+func (h *ServerHandler) GetPvzPvzIdReceptionsCurrent(ctx echo.Context, pvzId openapi_types.UUID) error {
+
+	reqCtx := ctx.Request().Context()
+
+	detail, err := h.Model.GetCurrentReception(reqCtx, pvzId)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "no reception in progress")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get reception")
+	}
+	return ctx.JSON(http.StatusOK, ConvertReceptionDetailToAPI(detail))
+}
+
+// Пакетное добавление товаров в приемку
+// (POST /receptions/{receptionId}/products:batch)
+func (h *ServerHandler) PostReceptionsReceptionIdProductsBatch(ctx echo.Context, receptionId openapi_types.UUID) error {
+	var req api.PostReceptionsReceptionIdProductsBatchJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if len(req.Products) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "products must not be empty")
+	}
+	if len(req.Products) > h.Config.BatchMaxProducts {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("at most %d products per batch", h.Config.BatchMaxProducts))
+	}
+
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+	if err := h.authorizeReception(ctx, receptionId); err != nil {
+		return err
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	results := make([]api.ProductBatchResult, len(req.Products))
+	var valid []api.ProductBatchItem
+	var validIndex []int
+	for i, item := range req.Products {
+		results[i].Index = i
+		details := api.PostProductsJSONBody{
+			Type:        item.Type,
+			Barcode:     item.Barcode,
+			Sku:         item.Sku,
+			WeightGrams: item.WeightGrams,
+			Dimensions:  item.Dimensions,
+		}
+		if !ValidProductDetails(details) {
+			results[i].Status = api.Rejected
+			results[i].Error = stringPtr("Invalid request format")
+			continue
+		}
+		valid = append(valid, item)
+		validIndex = append(validIndex, i)
+	}
+
+	added, err := h.Model.AddProducts(reqCtx, receptionId, valid, userID)
+	if errors.Is(err, data.ErrReceptionNotInProgress) {
+		return echo.NewHTTPError(http.StatusConflict, "reception is not in progress")
+	}
+	if errors.Is(err, data.ErrDuplicateBarcode) {
+		return echo.NewHTTPError(http.StatusConflict, "a product with one of these barcodes was added concurrently, retry the batch")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to add products")
+	}
+
+	for j, result := range added {
+		i := validIndex[j]
+		switch {
+		case errors.Is(result.Err, data.ErrUnknownProductType):
+			results[i].Status = api.Rejected
+			results[i].Error = stringPtr("Invalid product type")
+		case errors.Is(result.Err, data.ErrDuplicateBarcode):
+			results[i].Status = api.Rejected
+			results[i].Error = stringPtr("product with this barcode is already in the reception")
+		default:
+			product := TransformAddProductsRowToProduct(result.Product)
+			results[i].Status = api.Created
+			results[i].Product = &product
+		}
+	}
+	return ctx.JSON(http.StatusOK, api.ProductBatchResponse{Results: results})
+}
+
+// Отмена приемки в процессе
+// (POST /receptions/{receptionId}/cancel)
+func (h *ServerHandler) PostReceptionsReceptionIdCancel(ctx echo.Context, receptionId openapi_types.UUID) error {
+	return h.transitionReception(ctx, receptionId, data.TransitionCancel, "")
+}
+
+// Переоткрытие закрытой приемки с указанием причины
+// (POST /receptions/{receptionId}/reopen)
+func (h *ServerHandler) PostReceptionsReceptionIdReopen(ctx echo.Context, receptionId openapi_types.UUID) error {
+	var req api.PostReceptionsReceptionIdReopenJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if len(req.Reason) > 500 {
+		return echo.NewHTTPError(http.StatusBadRequest, "reason must be at most 500 characters")
+	}
+	return h.transitionReception(ctx, receptionId, data.TransitionReopen, req.Reason)
+}
+
+// Подтверждение закрытой приемки
+// (POST /receptions/{receptionId}/verify)
+func (h *ServerHandler) PostReceptionsReceptionIdVerify(ctx echo.Context, receptionId openapi_types.UUID) error {
+	return h.transitionReception(ctx, receptionId, data.TransitionVerify, "")
+}
+
+// История смены статусов приемки
+// (GET /receptions/{receptionId}/transitions)
+func (h *ServerHandler) GetReceptionsReceptionIdTransitions(ctx echo.Context, receptionId openapi_types.UUID) error {
+	if err := h.authorizeReception(ctx, receptionId); err != nil {
+		return err
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	history, err := h.Model.ReceptionHistory(reqCtx, receptionId)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "reception not found")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get reception history")
+	}
+
+	resp := make([]api.ReceptionTransition, 0, len(history))
+	for _, row := range history {
+		resp = append(resp, ConvertReceptionTransitionToAPI(row))
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *ServerHandler) transitionReception(ctx echo.Context, receptionId openapi_types.UUID, transition data.ReceptionTransition, reason string) error {
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+	if err := h.authorizeReception(ctx, receptionId); err != nil {
+		return err
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	reception, err := h.Model.TransitionReception(reqCtx, receptionId, transition, userID, reason)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "reception not found")
+	}
+	if errors.Is(err, data.ErrReasonRequired) {
+		return echo.NewHTTPError(http.StatusBadRequest, "reason is required")
+	}
+	if errors.Is(err, data.ErrInvalidTransition) {
+		return echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("cannot %s the reception in its current status", transition))
+	}
+	if errors.Is(err, data.ErrPVZHasOpenReception) {
+		return echo.NewHTTPError(http.StatusConflict, "pvz has a reception in progress")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to change reception status")
+	}
+	return ctx.JSON(http.StatusOK, ConvertReceptionStatusRowToAPI(reception))
+}
+
+// authorizeReception checks the caller's grant against the PVZ of
+// receptionId: the path has no pvzId for Authorizer to check.
+func (h *ServerHandler) authorizeReception(ctx echo.Context, receptionId openapi_types.UUID) error {
+	reqCtx := ctx.Request().Context()
+
+	pvzID, err := h.Model.ReceptionPVZ(reqCtx, receptionId)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "reception not found")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get reception")
+	}
+	addLogAttrs(ctx, "pvz_id", pvzID.String())
+
+	scope, _ := ctx.Get(ScopeKey).(policy.Scope)
+	if scope == policy.ScopeAll {
+		return nil
+	}
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+	userCity, _ := ctx.Get(CityKey).(string)
+	return h.authorizer().CheckScope(reqCtx, scope, userID, userCity, ResourceRef{PvzId: &pvzID})
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/data"
+	"github.com/wisp167/pvz/internal/helpers"
+)
+
+// Список сотрудников, закрепленных за ПВЗ (только для модераторов)
+// (GET /pvz/{pvzId}/staff)
+func (h *ServerHandler) GetPvzPvzIdStaff(ctx echo.Context, pvzId openapi_types.UUID) error {
+
+	reqCtx := ctx.Request().Context()
+
+	rows, err := h.Model.ListStaff(reqCtx, pvzId)
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list staff")
+	}
+
+	staff := make([]api.StaffAssignment, 0, len(rows))
+	for _, row := range rows {
+		staff = append(staff, ConvertListStaffRowToAPI(row))
+	}
+	return ctx.JSON(http.StatusOK, staff)
+}
+
+// Закрепление сотрудника за ПВЗ (только для модераторов)
+// (POST /pvz/{pvzId}/staff)
+func (h *ServerHandler) PostPvzPvzIdStaff(ctx echo.Context, pvzId openapi_types.UUID) error {
+	var req api.PostPvzPvzIdStaffJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+
+	userID, ok := UserID(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid token claims")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	staff, err := h.Model.AssignStaff(reqCtx, pvzId, req.UserId, userID)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusBadRequest, "pvz not found or user is not an employee")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to assign staff")
+	}
+	return ctx.JSON(http.StatusCreated, ConvertPvzStaffToAPI(staff))
+}
+
+// Открепление сотрудника от ПВЗ (только для модераторов)
+// (DELETE /pvz/{pvzId}/staff/{userId})
+func (h *ServerHandler) DeletePvzPvzIdStaffUserId(ctx echo.Context, pvzId openapi_types.UUID, userId openapi_types.UUID) error {
+
+	reqCtx := ctx.Request().Context()
+
+	err := h.Model.UnassignStaff(reqCtx, pvzId, userId)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "employee is not assigned to this pvz")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to unassign staff")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/data"
+	"github.com/wisp167/pvz/internal/db"
+	"github.com/wisp167/pvz/internal/helpers"
+	"github.com/wisp167/pvz/internal/policy"
+)
+
+// Список пользователей (право user:read)
+// (GET /users)
+func (h *ServerHandler) GetUsers(ctx echo.Context, params api.GetUsersParams) error {
+
+	reqCtx := ctx.Request().Context()
+
+	rows, err := h.Model.ListUsers(reqCtx, params)
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list users")
+	}
+
+	users := make([]api.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, ToUser(db.CreateUserRow(row)))
+	}
+	return ctx.JSON(http.StatusOK, users)
+}
+
+// Создание пользователя с любой ролью (право user:manage)
+// (POST /users)
+func (h *ServerHandler) PostUsers(ctx echo.Context) error {
+	var req api.PostUsersJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	user, err := h.Model.CreateUser(reqCtx, req)
+	if errors.Is(err, data.ErrDuplicateEmail) {
+		return echo.NewHTTPError(http.StatusBadRequest, "user already exists")
+	}
+	if errors.Is(err, data.ErrUnknownCity) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid city")
+	}
+	if errors.Is(err, data.ErrUnknownRole) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid role")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user")
+	}
+	return ctx.JSON(http.StatusCreated, ToUser(user))
+}
+
+// Изменение роли и города пользователя (право user:manage)
+// (PATCH /users/{userId})
+func (h *ServerHandler) PatchUsersUserId(ctx echo.Context, userId openapi_types.UUID) error {
+	var req api.PatchUsersUserIdJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	user, err := h.Model.UpdateUser(reqCtx, userId, req)
+	if errors.Is(err, data.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "user not found")
+	}
+	if errors.Is(err, data.ErrUnknownCity) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid city")
+	}
+	if errors.Is(err, data.ErrUnknownRole) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid role")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update user")
+	}
+	return ctx.JSON(http.StatusOK, ToUser(db.CreateUserRow(user)))
+}
+
+// Список ролей и их прав (право role:read)
+// (GET /roles)
+func (h *ServerHandler) GetRoles(ctx echo.Context) error {
+
+	reqCtx := ctx.Request().Context()
+
+	roles, err := h.Model.ListRoles(reqCtx)
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list roles")
+	}
+
+	resp := make([]api.Role, 0, len(roles))
+	for _, role := range roles {
+		resp = append(resp, ConvertRoleToAPI(role))
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Создание роли или замена ее прав (право role:manage)
+// (PUT /roles/{role})
+func (h *ServerHandler) PutRolesRole(ctx echo.Context, role string) error {
+	var req api.PutRolesRoleJSONBody
+	if err := helpers.ReadJSON(ctx, &req); err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if role == "" || len(role) > 50 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid role")
+	}
+
+	saved := data.Role{Name: role}
+	if req.Description != nil {
+		saved.Description = *req.Description
+	}
+	for _, perm := range req.Permissions {
+		saved.Grants = append(saved.Grants, policy.Grant{Permission: perm.Permission, Scope: policy.Scope(perm.Scope)})
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	err := h.Model.SaveRole(reqCtx, saved)
+	if errors.Is(err, data.ErrInvalidGrant) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid permission")
+	}
+	if err != nil {
+		h.logError(ctx, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to save role")
+	}
+	return ctx.JSON(http.StatusOK, ConvertRoleToAPI(saved))
+}
@@ -1,30 +1,70 @@
 package handlers
 
-import "github.com/wisp167/pvz/api"
+import (
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/policy"
+)
 
-func RegisterHandlersMiddleware(router api.EchoRouter, si api.ServerInterface) {
-	RegisterHandlersMiddlewareWithBaseURL(router, si, "")
+func RegisterHandlersMiddleware(router api.EchoRouter, h *ServerHandler) {
+	RegisterHandlersMiddlewareWithBaseURL(router, h, "")
 }
 
 // i want to apply auth middleware before passing context to wrapper (thus redefinition)
-func RegisterHandlersMiddlewareWithBaseURL(router api.EchoRouter, si api.ServerInterface, baseURL string) {
+func RegisterHandlersMiddlewareWithBaseURL(router api.EchoRouter, h *ServerHandler, baseURL string) {
 
 	wrapper := api.ServerInterfaceWrapper{
-		Handler: si,
+		Handler: h,
 	}
 
-	//moderatorOnly := RoleRequired("moderator")
-	employeeOnly := RoleRequired("employee")
-	moderatorOnly := RoleRequired("moderator")
+	// which roles hold which permission is data, see the roles and role_permissions tables
+	require := h.authorizer().Require
+	// POST routes go through h.Idempotent after the permission check, so a
+	// replay is authorized like the original request; the anonymous ones
+	// refuse an Idempotency-Key instead
 
-	router.POST(baseURL+"/dummyLogin", wrapper.PostDummyLogin)
-	router.POST(baseURL+"/login", wrapper.PostLogin)
-	router.POST(baseURL+"/products", wrapper.PostProducts, employeeOnly)
-	router.GET(baseURL+"/pvz", wrapper.GetPvz)
-	router.POST(baseURL+"/pvz", wrapper.PostPvz, moderatorOnly)
-	router.POST(baseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception, employeeOnly)
-	router.POST(baseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct, employeeOnly)
-	router.POST(baseURL+"/receptions", wrapper.PostReceptions, employeeOnly)
-	router.POST(baseURL+"/register", wrapper.PostRegister)
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	router.GET(baseURL+"/admin/config", wrapper.GetAdminConfig, require(policy.ConfigRead))
+	router.GET(baseURL+"/cities", wrapper.GetCities, require(policy.PVZRead))
+	router.PUT(baseURL+"/cities/:name", wrapper.PutCitiesName, require(policy.CatalogManage))
+	router.GET(baseURL+"/cities/:name/events", wrapper.GetCitiesNameEvents, require(policy.PVZRead))
+	router.GET(baseURL+"/healthz", wrapper.GetHealthz)
+	router.GET(baseURL+"/readyz", wrapper.GetReadyz)
+	router.GET(baseURL+"/version", wrapper.GetVersion)
+	if h.Config.DummyLogin {
+		router.POST(baseURL+"/dummyLogin", wrapper.PostDummyLogin, rejectIdempotencyKey)
+	}
+	router.POST(baseURL+"/login", wrapper.PostLogin, rejectIdempotencyKey)
+	router.POST(baseURL+"/logout", wrapper.PostLogout, h.Idempotent)
+	router.GET(baseURL+"/product_types", wrapper.GetProductTypes, require(policy.PVZRead))
+	router.PUT(baseURL+"/product_types/:name", wrapper.PutProductTypesName, require(policy.CatalogManage))
+	router.POST(baseURL+"/products", wrapper.PostProducts, require(policy.ProductCreate), h.Idempotent)
+	router.GET(baseURL+"/pvz", wrapper.GetPvz, require(policy.PVZRead))
+	router.POST(baseURL+"/pvz", wrapper.PostPvz, require(policy.PVZCreate), h.Idempotent)
+	router.DELETE(baseURL+"/pvz/:pvzId", wrapper.DeletePvzPvzId, require(policy.PVZDelete))
+	router.GET(baseURL+"/pvz/:pvzId", wrapper.GetPvzPvzId, require(policy.PVZRead))
+	router.PATCH(baseURL+"/pvz/:pvzId", wrapper.PatchPvzPvzId, require(policy.PVZUpdate))
+	router.POST(baseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception, require(policy.ReceptionClose), h.Idempotent)
+	router.POST(baseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct, require(policy.ProductDelete), h.Idempotent)
+	router.GET(baseURL+"/pvz/:pvzId/events", wrapper.GetPvzPvzIdEvents, require(policy.PVZRead))
+	router.GET(baseURL+"/pvz/:pvzId/receptions", wrapper.GetPvzPvzIdReceptions, require(policy.PVZRead))
+	router.GET(baseURL+"/pvz/:pvzId/receptions/current", wrapper.GetPvzPvzIdReceptionsCurrent, require(policy.PVZRead))
+	router.GET(baseURL+"/pvz/:pvzId/staff", wrapper.GetPvzPvzIdStaff, require(policy.StaffRead))
+	router.POST(baseURL+"/pvz/:pvzId/staff", wrapper.PostPvzPvzIdStaff, require(policy.StaffManage), h.Idempotent)
+	router.DELETE(baseURL+"/pvz/:pvzId/staff/:userId", wrapper.DeletePvzPvzIdStaffUserId, require(policy.StaffManage))
+	router.POST(baseURL+"/receptions", wrapper.PostReceptions, require(policy.ReceptionCreate), h.Idempotent)
+	router.GET(baseURL+"/receptions/:receptionId", wrapper.GetReceptionsReceptionId, require(policy.PVZRead))
+	router.POST(baseURL+"/receptions/:receptionId/cancel", wrapper.PostReceptionsReceptionIdCancel, require(policy.ReceptionCancel), h.Idempotent)
+	// the colon of products:batch is escaped, echo would take it for a parameter
+	router.POST(baseURL+"/receptions/:receptionId/products\\:batch", wrapper.PostReceptionsReceptionIdProductsBatch, require(policy.ProductCreate), h.Idempotent)
+	router.POST(baseURL+"/receptions/:receptionId/reopen", wrapper.PostReceptionsReceptionIdReopen, require(policy.ReceptionReopen), h.Idempotent)
+	router.GET(baseURL+"/receptions/:receptionId/transitions", wrapper.GetReceptionsReceptionIdTransitions, require(policy.PVZRead))
+	router.POST(baseURL+"/receptions/:receptionId/verify", wrapper.PostReceptionsReceptionIdVerify, require(policy.ReceptionVerify), h.Idempotent)
+	router.POST(baseURL+"/register", wrapper.PostRegister, rejectIdempotencyKey)
+	router.GET(baseURL+"/roles", wrapper.GetRoles, require(policy.RoleRead))
+	router.PUT(baseURL+"/roles/:role", wrapper.PutRolesRole, require(policy.RoleManage))
+	router.POST(baseURL+"/token/refresh", wrapper.PostTokenRefresh, rejectIdempotencyKey)
+	router.GET(baseURL+"/users", wrapper.GetUsers, require(policy.UserRead))
+	router.POST(baseURL+"/users", wrapper.PostUsers, require(policy.UserManage), h.Idempotent)
+	router.PATCH(baseURL+"/users/:userId", wrapper.PatchUsersUserId, require(policy.UserManage))
 
 }
@@ -1,7 +1,6 @@
 package helpers
 
 import (
-	"crypto/md5"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +12,16 @@ import (
 )
 
 func ReadJSON(c echo.Context, dst any) error {
+	return readJSON(c, dst, false)
+}
+
+// ReadOptionalJSON is ReadJSON for endpoints whose body may be left out: an
+// empty body, whatever its Content-Length, leaves dst untouched.
+func ReadOptionalJSON(c echo.Context, dst any) error {
+	return readJSON(c, dst, true)
+}
+
+func readJSON(c echo.Context, dst any, optional bool) error {
 	// Set maximum bytes limit
 	maxBytes := 1_048_576
 	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, int64(maxBytes))
@@ -47,6 +56,9 @@ func ReadJSON(c echo.Context, dst any) error {
 			return echo.NewHTTPError(http.StatusBadRequest,
 				fmt.Sprintf("body contains unknown key %s", fieldName))
 		case errors.Is(err, io.EOF):
+			if optional {
+				return nil
+			}
 			return echo.NewHTTPError(http.StatusBadRequest, "body must not be empty")
 		case errors.As(err, &maxBytesError):
 			return echo.NewHTTPError(http.StatusRequestEntityTooLarge,
@@ -67,11 +79,3 @@ func ReadJSON(c echo.Context, dst any) error {
 
 	return nil
 }
-
-func Md5(data string) []byte {
-	return []byte(data)
-	h := md5.New()
-	h.Write([]byte(data))
-	return h.Sum(nil)
-	//return hex.EncodeToString(h.Sum(nil))
-}
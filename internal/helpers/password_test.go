@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordHasher(t *testing.T) {
+	hasher := NewArgon2idHasher()
+
+	hash, err := hasher.Hash("secret")
+	assert.NoError(t, err)
+
+	ok, rehash, err := hasher.Verify("secret", hash)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, rehash)
+
+	ok, _, err = hasher.Verify("wrong", hash)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// md5("secret") as stored by the original users table
+	ok, rehash, err = hasher.Verify("secret", "5ebe2294ecd0e0f08eab7690d2a6ee69")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, rehash)
+
+	bcryptHash, err := NewBcryptHasher(4).Hash("secret")
+	assert.NoError(t, err)
+	ok, rehash, err = hasher.Verify("secret", bcryptHash)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, rehash)
+}
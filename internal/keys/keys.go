@@ -0,0 +1,279 @@
+package keys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// Algorithms lists every signing algorithm a key can have.
+var Algorithms = []string{AlgRS256, AlgEdDSA}
+
+var (
+	ErrNoActiveKey  = errors.New("no active signing key")
+	ErrKeyNotFound  = errors.New("signing key not found")
+	ErrVerifyOnly   = errors.New("signing key has no private part")
+	ErrUnsupported  = errors.New("unsupported key type")
+	ErrDuplicateKID = errors.New("duplicate key id")
+)
+
+// SigningKey is one JWT key identified by its kid header. Retired keys keep
+// only the public part and are used for verification.
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+func (k *SigningKey) CanSign() bool {
+	return k.PrivateKey != nil
+}
+
+// Manager holds every key tokens may be verified with and signs new tokens
+// with the active one.
+type Manager struct {
+	mu     sync.RWMutex
+	active string
+	keys   map[string]*SigningKey
+}
+
+func NewManager() *Manager {
+	return &Manager{keys: make(map[string]*SigningKey)}
+}
+
+func (m *Manager) Add(key *SigningKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.keys[key.ID]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateKID, key.ID)
+	}
+	m.keys[key.ID] = key
+	return nil
+}
+
+func (m *Manager) Activate(kid string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key, ok := m.keys[kid]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+	}
+	if !key.CanSign() {
+		return fmt.Errorf("%w: %s", ErrVerifyOnly, kid)
+	}
+	m.active = kid
+	return nil
+}
+
+// Rotate adds key and makes it the active one. The previous key stays
+// available for verification.
+func (m *Manager) Rotate(key *SigningKey) error {
+	if err := m.Add(key); err != nil {
+		return err
+	}
+	return m.Activate(key.ID)
+}
+
+func (m *Manager) Active() (*SigningKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	key, ok := m.keys[m.active]
+	if !ok {
+		return nil, ErrNoActiveKey
+	}
+	return key, nil
+}
+
+func (m *Manager) Lookup(kid string) (*SigningKey, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	key, ok := m.keys[kid]
+	return key, ok
+}
+
+// Replace swaps the whole key set, e.g. after the key directory was reloaded.
+func (m *Manager) Replace(other *Manager) {
+	other.mu.RLock()
+	keys, active := other.keys, other.active
+	other.mu.RUnlock()
+
+	m.mu.Lock()
+	m.keys, m.active = keys, active
+	m.mu.Unlock()
+}
+
+// JWK is the public part of a key as published in the JWKS document (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS returns the public keys of every known key, active and retired.
+func (m *Manager) JWKS() []JWK {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := make([]string, 0, len(m.keys))
+	for kid := range m.keys {
+		ids = append(ids, kid)
+	}
+	sort.Strings(ids)
+
+	jwks := make([]JWK, 0, len(ids))
+	for _, kid := range ids {
+		key := m.keys[kid]
+		switch pub := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwks = append(jwks, JWK{
+				Kty: "RSA",
+				Kid: key.ID,
+				Use: "sig",
+				Alg: key.Algorithm,
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks = append(jwks, JWK{
+				Kty: "OKP",
+				Kid: key.ID,
+				Use: "sig",
+				Alg: key.Algorithm,
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+	return jwks
+}
+
+// GenerateRSAKey creates a fresh RS256 key, used when no key directory is configured.
+func GenerateRSAKey(kid string) (*SigningKey, error) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	return &SigningKey{ID: kid, Algorithm: AlgRS256, PrivateKey: priv, PublicKey: &priv.PublicKey}, nil
+}
+
+func GenerateEd25519Key(kid string) (*SigningKey, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &SigningKey{ID: kid, Algorithm: AlgEdDSA, PrivateKey: priv, PublicKey: pub}, nil
+}
+
+// LoadDir reads every *.pem file in dir. The file name without extension is
+// the kid. Private keys (PKCS#1 or PKCS#8) can sign, public keys (PKIX) are
+// retired keys kept for verification only. activeKID selects the signing key;
+// when empty the last private key in lexical order is used, so naming keys
+// by date rotates them automatically.
+func LoadDir(dir string, activeKID string) (*Manager, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	m := NewManager()
+	for _, path := range paths {
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := loadPEM(kid, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load key %s: %w", path, err)
+		}
+		if err := m.Add(key); err != nil {
+			return nil, err
+		}
+		if activeKID == "" && key.CanSign() {
+			m.active = kid
+		}
+	}
+
+	if activeKID != "" {
+		if err := m.Activate(activeKID); err != nil {
+			return nil, err
+		}
+	}
+	if m.active == "" {
+		return nil, fmt.Errorf("%w in %s", ErrNoActiveKey, dir)
+	}
+	return m, nil
+}
+
+func loadPEM(kid, path string) (*SigningKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		priv, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newKey(kid, priv, &priv.PublicKey)
+	case "PRIVATE KEY":
+		priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := priv.(crypto.Signer)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		return newKey(kid, signer, signer.Public())
+	case "PUBLIC KEY":
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newKey(kid, nil, pub)
+	default:
+		return nil, fmt.Errorf("%w: PEM block %q", ErrUnsupported, block.Type)
+	}
+}
+
+func newKey(kid string, priv crypto.Signer, pub crypto.PublicKey) (*SigningKey, error) {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return &SigningKey{ID: kid, Algorithm: AlgRS256, PrivateKey: priv, PublicKey: pub}, nil
+	case ed25519.PublicKey:
+		return &SigningKey{ID: kid, Algorithm: AlgEdDSA, PrivateKey: priv, PublicKey: pub}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupported, pub)
+	}
+}
@@ -0,0 +1,252 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func writePEM(t *testing.T, dir, kid, blockType string, der []byte) {
+	t.Helper()
+	raw := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), raw, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writePrivate(t *testing.T, dir string, key *SigningKey) {
+	t.Helper()
+	switch priv := key.PrivateKey.(type) {
+	case *rsa.PrivateKey:
+		writePEM(t, dir, key.ID, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(priv))
+	default:
+		der, err := x509.MarshalPKCS8PrivateKey(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		writePEM(t, dir, key.ID, "PRIVATE KEY", der)
+	}
+}
+
+func writePublic(t *testing.T, dir string, key *SigningKey) {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, dir, key.ID, "PUBLIC KEY", der)
+}
+
+func generate(t *testing.T, kid string, gen func(string) (*SigningKey, error)) *SigningKey {
+	t.Helper()
+	key, err := gen(kid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// keyDir holds a retired RSA key (public part only), an older RSA key and a
+// newer Ed25519 key, named by date.
+func keyDir(t *testing.T) (dir string, retired, older, newer *SigningKey) {
+	dir = t.TempDir()
+	retired = generate(t, "2024-01", GenerateRSAKey)
+	older = generate(t, "2024-06", GenerateRSAKey)
+	newer = generate(t, "2025-01", GenerateEd25519Key)
+	writePublic(t, dir, retired)
+	writePrivate(t, dir, older)
+	writePrivate(t, dir, newer)
+	// not a key
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("keys"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return dir, retired, older, newer
+}
+
+func TestLoadDir(t *testing.T) {
+	dir, retired, older, newer := keyDir(t)
+
+	m, err := LoadDir(dir, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, want := range []*SigningKey{retired, older, newer} {
+		key, ok := m.Lookup(want.ID)
+		if assert.True(t, ok, want.ID) {
+			assert.Equal(t, want.Algorithm, key.Algorithm, want.ID)
+			assert.Equal(t, want.PublicKey, key.PublicKey, want.ID)
+		}
+	}
+	key, _ := m.Lookup(retired.ID)
+	assert.False(t, key.CanSign())
+	key, _ = m.Lookup(older.ID)
+	assert.True(t, key.CanSign())
+	_, ok := m.Lookup("README")
+	assert.False(t, ok)
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := LoadDir(t.TempDir(), "")
+		assert.ErrorIs(t, err, ErrNoActiveKey)
+
+		retiredOnly := t.TempDir()
+		writePublic(t, retiredOnly, retired)
+		_, err = LoadDir(retiredOnly, "")
+		assert.ErrorIs(t, err, ErrNoActiveKey)
+
+		broken := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(broken, "k1.pem"), []byte("not a key"), 0o600))
+		_, err = LoadDir(broken, "")
+		assert.ErrorContains(t, err, "no PEM block found")
+
+		certificate := t.TempDir()
+		writePEM(t, certificate, "k1", "CERTIFICATE", []byte{1})
+		_, err = LoadDir(certificate, "")
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+}
+
+func TestActiveKey(t *testing.T) {
+	dir, retired, older, newer := keyDir(t)
+
+	for _, tc := range []struct {
+		name      string
+		activeKID string
+		want      string
+		wantErr   error
+	}{
+		{"last private key by name", "", newer.ID, nil},
+		{"configured", older.ID, older.ID, nil},
+		{"retired", retired.ID, "", ErrVerifyOnly},
+		{"unknown", "2030-01", "", ErrKeyNotFound},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := LoadDir(dir, tc.activeKID)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			key, err := m.Active()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, key.ID)
+		})
+	}
+
+	_, err := NewManager().Active()
+	assert.ErrorIs(t, err, ErrNoActiveKey)
+
+	// rotating keeps the previous key for verification
+	m := NewManager()
+	assert.NoError(t, m.Rotate(older))
+	assert.NoError(t, m.Rotate(newer))
+	key, _ := m.Active()
+	assert.Equal(t, newer.ID, key.ID)
+	_, ok := m.Lookup(older.ID)
+	assert.True(t, ok)
+	assert.ErrorIs(t, m.Add(older), ErrDuplicateKID)
+}
+
+func sign(t *testing.T, key *SigningKey) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), jwt.RegisteredClaims{
+		Subject:   "user",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	})
+	token.Header["kid"] = key.ID
+	signed, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+// verify checks a token the way the auth middleware does: by kid, with the
+// algorithm of that key.
+func verify(m *Manager, token string) error {
+	_, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		key, ok := m.Lookup(token.Header["kid"].(string))
+		if !ok {
+			return nil, ErrKeyNotFound
+		}
+		return key.PublicKey, nil
+	}, jwt.WithValidMethods(Algorithms))
+	return err
+}
+
+func TestVerifyRetiredKey(t *testing.T) {
+	dir, retired, older, newer := keyDir(t)
+	m, err := LoadDir(dir, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// issued before the key was retired, still good until it expires
+	assert.NoError(t, verify(m, sign(t, retired)))
+	assert.NoError(t, verify(m, sign(t, older)))
+	assert.NoError(t, verify(m, sign(t, newer)))
+
+	// after the retired key is removed from the directory and reloaded
+	assert.NoError(t, os.Remove(filepath.Join(dir, retired.ID+".pem")))
+	reloaded, err := LoadDir(dir, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	m.Replace(reloaded)
+	assert.ErrorIs(t, verify(m, sign(t, retired)), ErrKeyNotFound)
+
+	// same kid, different key
+	impostor := generate(t, older.ID, GenerateRSAKey)
+	assert.ErrorIs(t, verify(m, sign(t, impostor)), jwt.ErrTokenSignatureInvalid)
+}
+
+func TestJWKS(t *testing.T) {
+	dir, retired, older, newer := keyDir(t)
+	m, err := LoadDir(dir, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	jwks := m.JWKS()
+	if !assert.Len(t, jwks, 3) {
+		return
+	}
+	// retired keys are published too, sorted by kid
+	assert.Equal(t, []string{retired.ID, older.ID, newer.ID}, []string{jwks[0].Kid, jwks[1].Kid, jwks[2].Kid})
+
+	for i, key := range []*SigningKey{retired, older} {
+		jwk := jwks[i]
+		assert.Equal(t, "RSA", jwk.Kty)
+		assert.Equal(t, "sig", jwk.Use)
+		assert.Equal(t, AlgRS256, jwk.Alg)
+		assert.Equal(t, "AQAB", jwk.E)
+		assert.Empty(t, jwk.Crv)
+		assert.Empty(t, jwk.X)
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, new(big.Int).SetBytes(n).Cmp(key.PublicKey.(*rsa.PublicKey).N))
+	}
+
+	jwk := jwks[2]
+	assert.Equal(t, "OKP", jwk.Kty)
+	assert.Equal(t, "sig", jwk.Use)
+	assert.Equal(t, AlgEdDSA, jwk.Alg)
+	assert.Equal(t, "Ed25519", jwk.Crv)
+	assert.Empty(t, jwk.N)
+	assert.Empty(t, jwk.E)
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(newer.PublicKey.(ed25519.PublicKey)), x)
+}
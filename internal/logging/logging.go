@@ -0,0 +1,86 @@
+// Package logging builds the service's log/slog logger. Request-scoped
+// attributes (request ID, user, route, PVZ) travel in the context and are
+// added to every record logged with it.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/wisp167/pvz/internal/config"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// New returns a logger writing JSON, or text when cfg.Format is text or
+// is left empty in development.
+func New(w io.Writer, cfg config.Log, env string) *slog.Logger {
+	var level slog.Level
+	// Validate has already checked the level
+	level.UnmarshalText([]byte(cfg.Level))
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	if cfg.Format == "text" || (cfg.Format == "" && env == "development") {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+	return slog.New(contextHandler{handler})
+}
+
+type ctxKey struct{}
+
+// With returns a copy of ctx whose log records also carry args, given as
+// in slog.Logger.With. Later values of the same key win.
+func With(ctx context.Context, args ...any) context.Context {
+	if len(args) == 0 {
+		return ctx
+	}
+	prev, _ := ctx.Value(ctxKey{}).([]slog.Attr)
+	attrs := make([]slog.Attr, 0, len(prev)+len(args)/2)
+	attrs = append(attrs, prev...)
+
+	record := slog.Record{}
+	record.Add(args...)
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = upsert(attrs, attr)
+		return true
+	})
+	return context.WithValue(ctx, ctxKey{}, attrs)
+}
+
+func upsert(attrs []slog.Attr, attr slog.Attr) []slog.Attr {
+	for i := range attrs {
+		if attrs[i].Key == attr.Key {
+			attrs[i] = attr
+			return attrs
+		}
+	}
+	return append(attrs, attr)
+}
+
+// contextHandler adds the attributes stored by With and the trace ID of the
+// current span, so log lines can be matched with traces.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs, ok := ctx.Value(ctxKey{}).([]slog.Attr); ok {
+		r.AddAttrs(attrs...)
+	}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		r.AddAttrs(slog.String("trace_id", span.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,188 @@
+// Package metrics exposes Prometheus metrics for HTTP requests, the database
+// pool and reception/product events.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "pvz"
+
+// Metrics owns its registry, so a second Application in the same process
+// (as in the tests) does not clash with the first.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+
+	receptionsOpened    *prometheus.CounterVec
+	receptionsClosed    *prometheus.CounterVec
+	receptionsCancelled *prometheus.CounterVec
+	receptionsVerified  *prometheus.CounterVec
+	productsAdded       *prometheus.CounterVec
+	productsDeleted     *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by route and status code.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by route.",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		}, []string{"method", "route"}),
+		receptionsOpened: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "receptions_opened_total",
+			Help:      "Receptions opened, by city.",
+		}, []string{"city"}),
+		receptionsClosed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "receptions_closed_total",
+			Help:      "Receptions closed, by city.",
+		}, []string{"city"}),
+		receptionsCancelled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "receptions_cancelled_total",
+			Help:      "Receptions cancelled while in progress, by city.",
+		}, []string{"city"}),
+		receptionsVerified: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "receptions_verified_total",
+			Help:      "Closed receptions verified, by city.",
+		}, []string{"city"}),
+		productsAdded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "products_added_total",
+			Help:      "Products scanned into a reception, by type and city.",
+		}, []string{"type", "city"}),
+		productsDeleted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "products_deleted_total",
+			Help:      "Products removed with delete_last_product, by type and city.",
+		}, []string{"type", "city"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests, m.duration,
+		m.receptionsOpened, m.receptionsClosed, m.receptionsCancelled, m.receptionsVerified,
+		m.productsAdded, m.productsDeleted,
+	)
+	return m
+}
+
+// Handler serves the registry in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// RegisterDB exports sql.DBStats: open and in-use connections, waits and so on.
+func (m *Metrics) RegisterDB(db *sql.DB) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, namespace))
+}
+
+// RegisterOpenReceptions exports the receptions in progress per city. count
+// runs on every scrape, so the gauge stays right across instances.
+func (m *Metrics) RegisterOpenReceptions(count func(ctx context.Context) (map[string]int64, error)) {
+	m.registry.MustRegister(&openReceptions{
+		count: count,
+		desc: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "open_receptions"),
+			"Receptions currently in progress, by city.", []string{"city"}, nil),
+	})
+}
+
+// Middleware records every request under its route template, e.g.
+// /pvz/:pvzId, so PVZ ids do not blow up the label set.
+func (m *Metrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+			status := c.Response().Status
+			var he *echo.HTTPError
+			if errors.As(err, &he) {
+				status = he.Code
+			} else if err != nil {
+				status = http.StatusInternalServerError
+			}
+
+			method := c.Request().Method
+			m.requests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+			m.duration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
+
+// The methods below implement data.Events.
+
+func (m *Metrics) ReceptionOpened(city string) {
+	m.receptionsOpened.WithLabelValues(city).Inc()
+}
+
+func (m *Metrics) ReceptionClosed(city string) {
+	m.receptionsClosed.WithLabelValues(city).Inc()
+}
+
+func (m *Metrics) ReceptionCancelled(city string) {
+	m.receptionsCancelled.WithLabelValues(city).Inc()
+}
+
+func (m *Metrics) ReceptionVerified(city string) {
+	m.receptionsVerified.WithLabelValues(city).Inc()
+}
+
+func (m *Metrics) ProductAdded(productType, city string) {
+	m.productsAdded.WithLabelValues(productType, city).Inc()
+}
+
+func (m *Metrics) ProductDeleted(productType, city string) {
+	m.productsDeleted.WithLabelValues(productType, city).Inc()
+}
+
+type openReceptions struct {
+	count func(ctx context.Context) (map[string]int64, error)
+	desc  *prometheus.Desc
+}
+
+func (o *openReceptions) Describe(ch chan<- *prometheus.Desc) {
+	ch <- o.desc
+}
+
+func (o *openReceptions) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	counts, err := o.count(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(o.desc, err)
+		return
+	}
+	for city, n := range counts {
+		ch <- prometheus.MustNewConstMetric(o.desc, prometheus.GaugeValue, float64(n), city)
+	}
+}
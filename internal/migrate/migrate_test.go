@@ -0,0 +1,318 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"sort"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/internal/testdb"
+)
+
+func mapFS(files map[string]string) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for name, body := range files {
+		fsys["migrations/"+name] = &fstest.MapFile{Data: []byte(body)}
+	}
+	return fsys
+}
+
+func versions(migrations []Migration) []int64 {
+	var out []int64
+	for _, m := range migrations {
+		out = append(out, m.Version)
+	}
+	return out
+}
+
+func TestLoad(t *testing.T) {
+	migrations, err := load(mapFS(map[string]string{
+		"0010_later.up.sql":    "SELECT 10",
+		"0002_second.up.sql":   "SELECT 2",
+		"0002_second.down.sql": "SELECT -2",
+		"0001_init.up.sql":     "SELECT 1",
+	}))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []int64{1, 2, 10}, versions(migrations))
+	assert.Equal(t, "second", migrations[1].Name)
+	assert.Equal(t, "SELECT 2", migrations[1].Up)
+	assert.Equal(t, "SELECT -2", migrations[1].Down)
+	assert.Empty(t, migrations[2].Down)
+
+	for name, files := range map[string]map[string]string{
+		"no direction":    {"0001_init.sql": "SELECT 1"},
+		"no version":      {"init.up.sql": "SELECT 1"},
+		"zero version":    {"0000_init.up.sql": "SELECT 1"},
+		"two names":       {"0001_init.up.sql": "SELECT 1", "0001_other.down.sql": "SELECT 1"},
+		"down without up": {"0001_init.up.sql": "SELECT 1", "0002_next.down.sql": "SELECT 1"},
+		"not a number":    {"00x1_init.up.sql": "SELECT 1"},
+		"no name":         {"0001.up.sql": "SELECT 1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := load(mapFS(files))
+			assert.Error(t, err)
+		})
+	}
+
+	// only *.sql files are migrations
+	migrations, err = load(mapFS(map[string]string{"README.md": "", "0001_init.up.sql": "SELECT 1"}))
+	assert.NoError(t, err)
+	assert.Len(t, migrations, 1)
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := load(files)
+	if !assert.NoError(t, err) {
+		return
+	}
+	for i, m := range migrations {
+		assert.Equal(t, int64(i+1), m.Version, "versions have no gaps")
+		assert.NotEmpty(t, m.Down, "%04d_%s has a down script", m.Version, m.Name)
+	}
+	assert.Equal(t, baselineName, migrations[0].Name)
+}
+
+// fakeMigrations are small enough to reason about step counts.
+var fakeMigrations = map[string]string{
+	"0001_init.up.sql":     "CREATE TABLE users (id INT); CREATE TABLE pvz (id INT);",
+	"0001_init.down.sql":   "DROP TABLE pvz; DROP TABLE users;",
+	"0002_second.up.sql":   "CREATE TABLE second (id INT);",
+	"0002_second.down.sql": "DROP TABLE second;",
+	"0003_third.up.sql":    "CREATE TABLE third (id INT);",
+	"0003_third.down.sql":  "DROP TABLE third;",
+}
+
+func newTestMigrator(t *testing.T, files map[string]string) (*Migrator, *sql.DB) {
+	db, _ := testdb.New(t)
+	migrations, err := load(mapFS(files))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return &Migrator{db: db, migrations: migrations}, db
+}
+
+func tableExists(t *testing.T, db *sql.DB, name string) bool {
+	var exists bool
+	err := db.QueryRow(`SELECT to_regclass($1) IS NOT NULL`, name).Scan(&exists)
+	assert.NoError(t, err)
+	return exists
+}
+
+func TestUpDown(t *testing.T) {
+	ctx := context.Background()
+	m, db := newTestMigrator(t, fakeMigrations)
+
+	applied, err := m.Up(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, versions(applied))
+	assert.True(t, tableExists(t, db, "third"))
+
+	applied, err = m.Up(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+
+	reverted, err := m.Down(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, versions(reverted))
+	assert.False(t, tableExists(t, db, "second"))
+
+	pending, err := m.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, versions(pending))
+
+	// asking for more than is applied reverts what there is
+	reverted, err = m.Down(ctx, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, versions(reverted))
+	assert.False(t, tableExists(t, db, "users"))
+
+	applied, err = m.Up(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, applied, 3)
+}
+
+func TestFailedMigrationLeavesNothing(t *testing.T) {
+	ctx := context.Background()
+	files := map[string]string{
+		"0001_init.up.sql":   fakeMigrations["0001_init.up.sql"],
+		"0002_broken.up.sql": "CREATE TABLE half (id INT); SELECT no_such_column FROM half;",
+	}
+	m, db := newTestMigrator(t, files)
+
+	applied, err := m.Up(ctx)
+	assert.ErrorContains(t, err, "migration 2_broken")
+	assert.Equal(t, []int64{1}, versions(applied))
+	assert.False(t, tableExists(t, db, "half"))
+
+	pending, err := m.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2}, versions(pending))
+}
+
+func TestDownWithoutDownScript(t *testing.T) {
+	ctx := context.Background()
+	files := map[string]string{
+		"0001_init.up.sql":    fakeMigrations["0001_init.up.sql"],
+		"0001_init.down.sql":  fakeMigrations["0001_init.down.sql"],
+		"0002_one_way.up.sql": "CREATE TABLE one_way (id INT);",
+	}
+	m, db := newTestMigrator(t, files)
+
+	_, err := m.Up(ctx)
+	assert.NoError(t, err)
+
+	reverted, err := m.Down(ctx, 2)
+	assert.ErrorContains(t, err, "2_one_way has no down script")
+	assert.Empty(t, reverted)
+	assert.True(t, tableExists(t, db, "one_way"))
+
+	pending, err := m.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestDownUnknownMigration(t *testing.T) {
+	ctx := context.Background()
+	m, db := newTestMigrator(t, fakeMigrations)
+
+	_, err := m.Up(ctx)
+	assert.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO schema_migrations (version, name) VALUES (99, 'from_a_newer_binary')`)
+	assert.NoError(t, err)
+
+	_, err = m.Down(ctx, 1)
+	assert.ErrorIs(t, err, ErrNoMigration)
+
+	statuses, err := m.Status(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, statuses, 4) {
+		assert.Equal(t, int64(99), statuses[3].Version)
+		assert.Empty(t, statuses[3].Name)
+		assert.NotNil(t, statuses[3].AppliedAt)
+	}
+}
+
+func TestStatusIsReadOnly(t *testing.T) {
+	ctx := context.Background()
+	m, db := newTestMigrator(t, fakeMigrations)
+
+	statuses, err := m.Status(ctx)
+	assert.ErrorIs(t, err, ErrNotInitialized)
+	assert.Len(t, statuses, 3)
+	for _, s := range statuses {
+		assert.Nil(t, s.AppliedAt)
+	}
+	assert.False(t, tableExists(t, db, "schema_migrations"))
+
+	// nor does it take the lock: it answers while a migration holds it
+	conn, err := db.Conn(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	_, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockKey)
+	assert.NoError(t, err)
+	defer conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, lockKey)
+
+	_, err = m.Status(ctx)
+	assert.ErrorIs(t, err, ErrNotInitialized)
+	pending, err := m.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 3)
+}
+
+func TestStampBaseline(t *testing.T) {
+	ctx := context.Background()
+	m, db := newTestMigrator(t, fakeMigrations)
+
+	// tables created by init.sql before there was a schema_migrations
+	_, err := db.Exec(`CREATE TABLE users (id INT); CREATE TABLE pvz (id INT); INSERT INTO users VALUES (1)`)
+	assert.NoError(t, err)
+
+	applied, err := m.Up(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, versions(applied))
+
+	statuses, err := m.Status(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, statuses, 3) {
+		assert.NotNil(t, statuses[0].AppliedAt)
+	}
+	var users int
+	assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&users))
+	assert.Equal(t, 1, users)
+
+	// an empty database is not stamped
+	m, _ = newTestMigrator(t, fakeMigrations)
+	applied, err = m.Up(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, versions(applied))
+}
+
+func TestEmbeddedUpDown(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testdb.New(t)
+	m, err := New(db)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	applied, err := m.Up(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, applied, len(m.migrations))
+
+	reverted, err := m.Down(ctx, len(m.migrations))
+	assert.NoError(t, err)
+	assert.Len(t, reverted, len(m.migrations))
+	assert.False(t, tableExists(t, db, "users"))
+
+	applied, err = m.Up(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, applied, len(m.migrations))
+}
+
+// Before the migrator existed docker-entrypoint-initdb.d ran the up scripts up
+// to 0009 in order. Such a database is stamped at 0001 and takes the rest on top.
+func TestEmbeddedOverInitdb(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testdb.New(t)
+
+	names, err := fs.Glob(files, "migrations/000*.up.sql")
+	if !assert.NoError(t, err) {
+		return
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		body, err := fs.ReadFile(files, name)
+		assert.NoError(t, err)
+		_, err = db.Exec(string(body))
+		if !assert.NoError(t, err, name) {
+			return
+		}
+	}
+	_, err = db.Exec(`INSERT INTO users (email, password_hash, role, city) VALUES ('a@example.com', 'x', 'employee', 'Казань')`)
+	assert.NoError(t, err)
+
+	m, err := New(db)
+	if !assert.NoError(t, err) {
+		return
+	}
+	applied, err := m.Up(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(2), applied[0].Version)
+	assert.Len(t, applied, len(m.migrations)-1)
+
+	var users int
+	assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&users))
+	assert.Equal(t, 1, users)
+	assert.False(t, tableExists(t, db, "product_deletions"))
+}
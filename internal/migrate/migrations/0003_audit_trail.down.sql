@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS product_deletions;
+
+ALTER TABLE products DROP COLUMN IF EXISTS created_by;
+
+ALTER TABLE receptions
+    DROP COLUMN IF EXISTS closed_at,
+    DROP COLUMN IF EXISTS closed_by,
+    DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,20 @@
+-- Who opened and closed a reception and who added a product
+ALTER TABLE receptions
+    ADD COLUMN IF NOT EXISTS created_by UUID REFERENCES users(id),
+    ADD COLUMN IF NOT EXISTS closed_by UUID REFERENCES users(id),
+    ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS created_by UUID REFERENCES users(id);
+
+-- Products removed by "delete last product", kept for auditing
+CREATE TABLE IF NOT EXISTS product_deletions (
+    product_id UUID PRIMARY KEY,
+    reception_id UUID NOT NULL REFERENCES receptions(id),
+    type VARCHAR(20) NOT NULL,
+    scanned_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID REFERENCES users(id),
+    deleted_by UUID REFERENCES users(id),
+    deleted_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_deletions_reception_id ON product_deletions(reception_id);
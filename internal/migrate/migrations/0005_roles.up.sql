@@ -0,0 +1,58 @@
+-- Roles; what each role may do lives in role_permissions
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(50) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Permissions granted to a role. The scope narrows a grant to the PVZs the
+-- user is assigned to ('assigned') or to the user's city ('city').
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR(50) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission VARCHAR(50) NOT NULL,
+    scope VARCHAR(20) NOT NULL DEFAULT 'all' CHECK (scope IN ('all', 'assigned', 'city')),
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO roles (name, description) VALUES
+    ('employee', 'Сотрудник ПВЗ'),
+    ('moderator', 'Модератор'),
+    ('admin', 'Администратор, управление пользователями и ролями'),
+    ('auditor', 'Аудитор, только чтение'),
+    ('regional_manager', 'Региональный менеджер, управление ПВЗ своего города')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission, scope) VALUES
+    ('employee', 'pvz:read', 'all'),
+    ('employee', 'reception:create', 'assigned'),
+    ('employee', 'reception:close', 'assigned'),
+    ('employee', 'product:create', 'assigned'),
+    ('employee', 'product:delete', 'assigned'),
+    ('moderator', 'pvz:read', 'all'),
+    ('moderator', 'pvz:create', 'all'),
+    ('moderator', 'staff:read', 'all'),
+    ('moderator', 'staff:manage', 'all'),
+    ('admin', 'pvz:read', 'all'),
+    ('admin', 'staff:read', 'all'),
+    ('admin', 'user:read', 'all'),
+    ('admin', 'user:manage', 'all'),
+    ('admin', 'role:read', 'all'),
+    ('admin', 'role:manage', 'all'),
+    ('auditor', 'pvz:read', 'all'),
+    ('auditor', 'staff:read', 'all'),
+    ('auditor', 'user:read', 'all'),
+    ('auditor', 'role:read', 'all'),
+    ('regional_manager', 'pvz:read', 'city'),
+    ('regional_manager', 'pvz:create', 'city'),
+    ('regional_manager', 'staff:read', 'city'),
+    ('regional_manager', 'staff:manage', 'city')
+ON CONFLICT DO NOTHING;
+
+-- users.role now names a row of roles instead of one of two fixed values
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ALTER COLUMN role TYPE VARCHAR(50);
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_fkey;
+ALTER TABLE users ADD CONSTRAINT users_role_fkey FOREIGN KEY (role) REFERENCES roles(name);
+
+-- Scope of city-bound roles such as regional_manager
+ALTER TABLE users ADD COLUMN IF NOT EXISTS city VARCHAR(50);
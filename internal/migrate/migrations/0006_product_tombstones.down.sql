@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS product_deletions (
+    product_id UUID PRIMARY KEY,
+    reception_id UUID NOT NULL REFERENCES receptions(id),
+    type VARCHAR(20) NOT NULL,
+    scanned_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID REFERENCES users(id),
+    deleted_by UUID REFERENCES users(id),
+    deleted_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_deletions_reception_id ON product_deletions(reception_id);
+
+-- tombstones go back to the deletion log
+INSERT INTO product_deletions (product_id, reception_id, type, scanned_at, created_by, deleted_by, deleted_at)
+SELECT id, reception_id, type, date_time, created_by, deleted_by, deleted_at
+FROM products
+WHERE deleted_at IS NOT NULL
+ON CONFLICT (product_id) DO NOTHING;
+
+DELETE FROM products WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_products_reception_live;
+ALTER TABLE products
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS deleted_at;
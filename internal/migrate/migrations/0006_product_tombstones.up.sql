@@ -0,0 +1,14 @@
+-- "Delete last product" leaves a tombstone instead of removing the row
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS deleted_by UUID REFERENCES users(id);
+
+CREATE INDEX IF NOT EXISTS idx_products_reception_live ON products(reception_id, sequence DESC) WHERE deleted_at IS NULL;
+
+-- products deleted before come back as tombstones
+INSERT INTO products (id, date_time, type, reception_id, created_by, deleted_at, deleted_by)
+SELECT product_id, COALESCE(scanned_at, deleted_at), type, reception_id, created_by, deleted_at, deleted_by
+FROM product_deletions
+ON CONFLICT (id) DO NOTHING;
+
+DROP TABLE product_deletions;
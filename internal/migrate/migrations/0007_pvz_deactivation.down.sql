@@ -0,0 +1,5 @@
+DELETE FROM role_permissions WHERE permission IN ('pvz:update', 'pvz:delete');
+
+ALTER TABLE pvz
+    DROP COLUMN IF EXISTS deactivated_by,
+    DROP COLUMN IF EXISTS deactivated_at;
@@ -0,0 +1,11 @@
+-- Set by DELETE /pvz/{pvzId}; a deactivated PVZ keeps its history
+ALTER TABLE pvz
+    ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS deactivated_by UUID REFERENCES users(id);
+
+INSERT INTO role_permissions (role, permission, scope) VALUES
+    ('moderator', 'pvz:update', 'all'),
+    ('moderator', 'pvz:delete', 'all'),
+    ('regional_manager', 'pvz:update', 'city'),
+    ('regional_manager', 'pvz:delete', 'city')
+ON CONFLICT DO NOTHING;
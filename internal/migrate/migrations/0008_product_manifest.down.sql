@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_products_reception_barcode;
+ALTER TABLE products
+    DROP COLUMN IF EXISTS height_mm,
+    DROP COLUMN IF EXISTS width_mm,
+    DROP COLUMN IF EXISTS length_mm,
+    DROP COLUMN IF EXISTS weight_grams,
+    DROP COLUMN IF EXISTS sku,
+    DROP COLUMN IF EXISTS barcode;
@@ -0,0 +1,11 @@
+-- Manifest data scanned with a product; all of it is optional
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS barcode VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS sku VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS weight_grams INTEGER CHECK (weight_grams > 0),
+    ADD COLUMN IF NOT EXISTS length_mm INTEGER CHECK (length_mm > 0),
+    ADD COLUMN IF NOT EXISTS width_mm INTEGER CHECK (width_mm > 0),
+    ADD COLUMN IF NOT EXISTS height_mm INTEGER CHECK (height_mm > 0);
+
+-- A barcode may be scanned once per reception; undoing the scan frees it
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_reception_barcode ON products(reception_id, barcode) WHERE barcode IS NOT NULL AND deleted_at IS NULL;
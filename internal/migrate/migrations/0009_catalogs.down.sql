@@ -0,0 +1,16 @@
+DELETE FROM role_permissions WHERE permission = 'catalog:manage';
+
+-- rows using cities or types added through the catalogs are left as they
+-- are; the checks only apply to rows written from now on
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_city_fkey;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_type_fkey;
+ALTER TABLE products ADD CONSTRAINT products_type_check
+    CHECK (type IN ('электроника', 'одежда', 'обувь')) NOT VALID;
+
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_city_fkey;
+ALTER TABLE pvz ADD CONSTRAINT pvz_city_check
+    CHECK (city IN ('Москва', 'Санкт-Петербург', 'Казань')) NOT VALID;
+
+DROP TABLE IF EXISTS product_types;
+DROP TABLE IF EXISTS cities;
@@ -0,0 +1,32 @@
+-- Reference catalogs; inactive entries stay for existing rows but can't be used for new ones
+CREATE TABLE IF NOT EXISTS cities (
+    name VARCHAR(50) PRIMARY KEY,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS product_types (
+    name VARCHAR(50) PRIMARY KEY,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+INSERT INTO cities (name) VALUES ('Москва'), ('Санкт-Петербург'), ('Казань') ON CONFLICT DO NOTHING;
+INSERT INTO product_types (name) VALUES ('электроника'), ('одежда'), ('обувь') ON CONFLICT DO NOTHING;
+
+-- the fixed lists in the checks become references to the catalogs
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_city_check;
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_city_fkey;
+ALTER TABLE pvz ADD CONSTRAINT pvz_city_fkey FOREIGN KEY (city) REFERENCES cities(name);
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_type_check;
+ALTER TABLE products ALTER COLUMN type TYPE VARCHAR(50);
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_type_fkey;
+ALTER TABLE products ADD CONSTRAINT products_type_fkey FOREIGN KEY (type) REFERENCES product_types(name);
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_city_fkey;
+ALTER TABLE users ADD CONSTRAINT users_city_fkey FOREIGN KEY (city) REFERENCES cities(name);
+
+INSERT INTO role_permissions (role, permission, scope) VALUES
+    ('admin', 'catalog:manage', 'all')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,3 @@
+INSERT INTO role_permissions (role, permission, scope) VALUES
+    ('admin', 'config:read', 'all')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,13 @@
+DELETE FROM role_permissions
+WHERE permission IN ('reception:cancel', 'reception:reopen', 'reception:verify');
+
+DROP TABLE IF EXISTS reception_transitions;
+
+-- The old schema only knows open and closed receptions: in_progress rows stay
+-- as they are, closed, cancelled and verified ones all become 'close' before
+-- the old check comes back. Which of them was cancelled or verified is lost
+-- with reception_transitions; migrating up again reads them all as closed.
+ALTER TABLE receptions DROP CONSTRAINT receptions_status_check;
+UPDATE receptions SET status = 'close' WHERE status IN ('closed', 'cancelled', 'verified');
+ALTER TABLE receptions ADD CONSTRAINT receptions_status_check
+    CHECK (status IN ('in_progress', 'close'));
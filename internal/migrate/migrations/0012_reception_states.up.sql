@@ -0,0 +1,32 @@
+-- Receptions move through in_progress -> closed -> verified, may be cancelled
+-- while in progress and reopened while closed. 'close' becomes 'closed'.
+ALTER TABLE receptions DROP CONSTRAINT receptions_status_check;
+UPDATE receptions SET status = 'closed' WHERE status = 'close';
+ALTER TABLE receptions ADD CONSTRAINT receptions_status_check
+    CHECK (status IN ('in_progress', 'closed', 'cancelled', 'verified'));
+
+-- Every status change of a reception, oldest first by id
+CREATE TABLE reception_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    reception_id UUID NOT NULL REFERENCES receptions(id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    reason TEXT,
+    actor_id UUID REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reception_transitions_reception_id ON reception_transitions(reception_id, id);
+
+-- receptions closed before the history existed
+INSERT INTO reception_transitions (reception_id, from_status, to_status, actor_id, created_at)
+SELECT id, 'in_progress', 'closed', closed_by, COALESCE(closed_at, updated_at, NOW())
+FROM receptions
+WHERE status = 'closed'
+ORDER BY COALESCE(closed_at, updated_at);
+
+INSERT INTO role_permissions (role, permission, scope) VALUES
+    ('employee', 'reception:cancel', 'assigned'),
+    ('moderator', 'reception:reopen', 'all'),
+    ('moderator', 'reception:verify', 'all')
+ON CONFLICT DO NOTHING;
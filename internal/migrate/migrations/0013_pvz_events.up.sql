@@ -0,0 +1,21 @@
+-- Reception and product events streamed to dashboards over SSE. Rows are
+-- published with NOTIFY on the pvz_events channel in the transaction that
+-- inserts them, and kept for events_retention so a client reconnecting with
+-- Last-Event-ID can catch up on what it missed.
+CREATE TABLE pvz_events (
+    id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(50) NOT NULL
+        CHECK (type IN ('reception.opened', 'reception.closed', 'reception.cancelled', 'reception.verified',
+                        'product.added', 'product.deleted')),
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    city VARCHAR(50) NOT NULL,
+    reception_id UUID NOT NULL,
+    product_id UUID,
+    product_type VARCHAR(50),
+    actor_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_pvz_events_pvz_id ON pvz_events(pvz_id, id);
+CREATE INDEX idx_pvz_events_city ON pvz_events(city, id);
+CREATE INDEX idx_pvz_events_created_at ON pvz_events(created_at);
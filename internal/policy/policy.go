@@ -0,0 +1,128 @@
+package policy
+
+import (
+	"sort"
+	"sync"
+)
+
+// Permissions checked by the HTTP routes. Which role holds which permission
+// is data in the role_permissions table.
+const (
+	PVZRead         = "pvz:read"
+	PVZCreate       = "pvz:create"
+	PVZUpdate       = "pvz:update"
+	PVZDelete       = "pvz:delete"
+	StaffRead       = "staff:read"
+	StaffManage     = "staff:manage"
+	ReceptionCreate = "reception:create"
+	ReceptionClose  = "reception:close"
+	ReceptionCancel = "reception:cancel"
+	ReceptionReopen = "reception:reopen"
+	ReceptionVerify = "reception:verify"
+	ProductCreate   = "product:create"
+	ProductDelete   = "product:delete"
+	UserRead        = "user:read"
+	UserManage      = "user:manage"
+	RoleRead        = "role:read"
+	RoleManage      = "role:manage"
+	CatalogManage   = "catalog:manage"
+	ConfigRead      = "config:read"
+)
+
+// Permissions lists every permission a role can be granted.
+var Permissions = []string{
+	PVZRead, PVZCreate, PVZUpdate, PVZDelete,
+	StaffRead, StaffManage,
+	ReceptionCreate, ReceptionClose, ReceptionCancel, ReceptionReopen, ReceptionVerify,
+	ProductCreate, ProductDelete,
+	UserRead, UserManage,
+	RoleRead, RoleManage,
+	CatalogManage,
+	ConfigRead,
+}
+
+func KnownPermission(permission string) bool {
+	for _, p := range Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope narrows a grant to a subset of PVZs.
+type Scope string
+
+const (
+	ScopeAll      Scope = "all"
+	ScopeAssigned Scope = "assigned" // PVZs the user is assigned to via pvz_staff
+	ScopeCity     Scope = "city"     // PVZs in the user's city
+)
+
+func (s Scope) Valid() bool {
+	switch s {
+	case ScopeAll, ScopeAssigned, ScopeCity:
+		return true
+	}
+	return false
+}
+
+type Grant struct {
+	Permission string
+	Scope      Scope
+}
+
+// Engine answers permission checks from an in-memory copy of the role table.
+type Engine struct {
+	mu    sync.RWMutex
+	roles map[string]map[string]Scope
+}
+
+func NewEngine() *Engine {
+	return &Engine{roles: make(map[string]map[string]Scope)}
+}
+
+// Load replaces the whole policy. A role with no grants is still a valid role.
+func (e *Engine) Load(grants map[string][]Grant) {
+	roles := make(map[string]map[string]Scope, len(grants))
+	for role, list := range grants {
+		perms := make(map[string]Scope, len(list))
+		for _, g := range list {
+			perms[g.Permission] = g.Scope
+		}
+		roles[role] = perms
+	}
+
+	e.mu.Lock()
+	e.roles = roles
+	e.mu.Unlock()
+}
+
+func (e *Engine) HasRole(role string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	_, ok := e.roles[role]
+	return ok
+}
+
+// Scope returns the scope within which role holds permission.
+func (e *Engine) Scope(role, permission string) (Scope, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	scope, ok := e.roles[role][permission]
+	return scope, ok
+}
+
+func (e *Engine) Roles() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	roles := make([]string, 0, len(e.roles))
+	for role := range e.roles {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/wisp167/pvz/internal/migrate"
+)
+
+// migrateUp applies pending migrations before the application touches the schema.
+func migrateUp(ctx context.Context, db *sql.DB, logger *slog.Logger) error {
+	migrator, err := migrate.New(db)
+	if err != nil {
+		return err
+	}
+	applied, err := migrator.Up(ctx)
+	for _, m := range applied {
+		logger.Info("applied migration", "version", m.Version, "name", m.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to migrate database: %v", err)
+	}
+	return nil
+}
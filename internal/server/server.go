@@ -3,152 +3,288 @@ package server
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
-	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	_ "github.com/lib/pq"
+	"github.com/wisp167/pvz/internal/config"
 	"github.com/wisp167/pvz/internal/data"
+	"github.com/wisp167/pvz/internal/grpcserver"
 	"github.com/wisp167/pvz/internal/handlers"
+	"github.com/wisp167/pvz/internal/keys"
+	"github.com/wisp167/pvz/internal/logging"
+	"github.com/wisp167/pvz/internal/metrics"
+	"github.com/wisp167/pvz/internal/stream"
+	"github.com/wisp167/pvz/internal/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 )
 
-const version = "1.0.0"
-
-type config struct {
-	port       int
-	env        string
-	numWorkers int
-	db         struct {
-		dsn          string
-		port         string
-		host         string
-		name         string
-		user         string
-		password     string
-		maxOpenConns int
-		maxIdleConns int
-		maxIdleTime  string
-	}
-}
-
 type Application struct {
-	config  config
-	logger  *log.Logger
+	config  config.Config
+	logger  *slog.Logger
 	model   *data.Models
-	queue   chan struct{}
-	jwtkey  []byte
+	keys    *keys.Manager
+	metrics *metrics.Metrics
 	server  *echo.Echo
+	grpc    *grpcserver.Server
+	stream  *stream.Broker
 	handler *handlers.ServerHandler
+	done    chan struct{}
+
+	// flushTraces exports the spans still buffered on shutdown
+	flushTraces func(context.Context) error
+
+	// ready is true from the moment the listener is bound until shutdown starts
+	ready    atomic.Bool
+	serveErr chan error
+	stopOnce sync.Once
+	stopErr  error
 }
 
+// SetupApplication configures the server from the environment and os.Args.
 func SetupApplication() (*Application, error) {
-	var cfg config
-	var jwtkey string
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		return nil, err
+	}
+	return NewApplication(cfg)
+}
 
-	godotenv.Load(".env")
+func NewApplication(cfg config.Config) (*Application, error) {
+	logger := logging.New(os.Stdout, cfg.Log, cfg.Env)
+	slog.SetDefault(logger)
 
-	logger := log.New(os.Stdout, "[Application]: ", log.Ldate|log.Ltime|log.Lshortfile)
+	logger.Info("loaded config", "config", json.RawMessage(cfg.String()))
 
-	EnvPort, err := strconv.Atoi(os.Getenv("PORT"))
+	flushTraces, err := tracing.Setup(context.Background(), cfg.Tracing, version)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse PORT: %v", err)
+		return nil, fmt.Errorf("failed to set up tracing: %v", err)
 	}
 
-	DbMaxOpenCons, err := strconv.Atoi(os.Getenv("DATABASE_MAX_OPEN_CONNS"))
+	model, err := OpenModels(cfg, logger)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse DATABASE_MAX_OPEN_CONNS: %v", err)
+		return nil, err
 	}
-	DbMaxIdleCons, err := strconv.Atoi(os.Getenv("DATABASE_MAX_IDLE_CONNS"))
+
+	keySet, err := loadKeys(cfg, logger)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse DATABASE_MAX_IDLE_CONNS: %v", err)
-	}
-	jwtKey := os.Getenv("JWT_KEY")
-	if jwtKey == "" {
-		return nil, fmt.Errorf("JWT_KEY environment variable is required")
+		return nil, fmt.Errorf("failed to load signing keys: %v", err)
 	}
-	flag.IntVar(&cfg.port, "port", EnvPort, "API server port")
-	flag.StringVar(&cfg.env, "env", os.Getenv("ENV"), "Environment (development|staging|production)")
 
-	flag.StringVar(&cfg.db.host, "db-host", os.Getenv("DATABASE_HOST"), "PostgreSQL host")
-	flag.StringVar(&cfg.db.name, "db-name", os.Getenv("DATABASE_NAME"), "PostgreSQL database name")
-	flag.StringVar(&cfg.db.user, "db-user", os.Getenv("DATABASE_USER"), "PostgreSQL user")
-	flag.StringVar(&cfg.db.port, "db-port", os.Getenv("DATABASE_PORT"), "PostgreSQL port")
+	m := metrics.New()
+	m.RegisterDB(model.PVZ.DB)
+	m.RegisterOpenReceptions(model.OpenReceptionsByCity)
+	model.Events = m
 
-	flag.StringVar(&cfg.db.password, "db-password", os.Getenv("DATABASE_PASSWORD"), "PostgreSQL password")
-
-	flag.IntVar(&cfg.db.maxOpenConns, "db-max-open-conns", DbMaxOpenCons, "PostgreSQL max open connections")
-	flag.IntVar(&cfg.db.maxIdleConns, "db-max-idle-conns", DbMaxIdleCons, "PostgreSQL max idle connections")
-	flag.StringVar(&cfg.db.maxIdleTime, "db-max-idle-time", os.Getenv("DATABASE_MAX_IDLE_TIME"), "PostgreSQL max connection idle time")
-
-	cfg.numWorkers = 50
-
-	flag.Parse()
+	app := &Application{
+		config:  cfg,
+		logger:  logger,
+		model:   model,
+		keys:    keySet,
+		metrics: m,
+		done:    make(chan struct{}),
+
+		flushTraces: flushTraces,
+	}
 
-	logger.Printf("Config: %v", cfg)
+	return app, nil
+}
 
+// OpenModels connects to the database, applies migrations when auto-migrate
+// is on and loads the role policy and catalogs. Models.Close releases it all.
+func OpenModels(cfg config.Config, logger *slog.Logger) (*data.Models, error) {
 	// Open the database connection
-	db, err := OpenDB(cfg)
+	db, err := OpenDB(cfg.DB)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %v", err)
 	}
 
+	if cfg.AutoMigrate {
+		if err := migrateUp(context.Background(), db, logger); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
 	model, err := data.NewModels(db)
 	if err != nil {
+		db.Close()
 		return nil, fmt.Errorf("failed to open database: %v", err)
 	}
 
-	app := &Application{
-		config: cfg,
-		logger: logger,
-		model:  &model,
-		jwtkey: []byte(jwtkey),
-		queue:  make(chan struct{}, cfg.numWorkers),
+	if err := model.LoadPolicy(context.Background()); err != nil {
+		model.Close(context.Background())
+		return nil, fmt.Errorf("failed to load roles: %v", err)
+	}
+	if err := model.LoadCatalog(context.Background()); err != nil {
+		model.Close(context.Background())
+		return nil, fmt.Errorf("failed to load catalogs: %v", err)
+	}
+	return &model, nil
+}
+
+// loadKeys reads the JWT key directory. Without one, development and testing
+// get a random key; anywhere else every restart would log everyone out, and
+// instances would reject each other's tokens, so startup fails instead.
+func loadKeys(cfg config.Config, logger *slog.Logger) (*keys.Manager, error) {
+	if cfg.JWT.KeysDir != "" {
+		return keys.LoadDir(cfg.JWT.KeysDir, cfg.JWT.ActiveKID)
+	}
+	if cfg.Env != "development" && cfg.Env != "testing" {
+		return nil, fmt.Errorf("JWT_KEYS_DIR is required when env is %s", cfg.Env)
 	}
 
-	return app, nil
+	logger.Warn("JWT_KEYS_DIR is not set, generating an ephemeral signing key; tokens will not survive a restart")
+	key, err := keys.GenerateRSAKey("ephemeral-" + time.Now().UTC().Format("20060102150405"))
+	if err != nil {
+		return nil, err
+	}
+	keySet := keys.NewManager()
+	if err := keySet.Rotate(key); err != nil {
+		return nil, err
+	}
+	return keySet, nil
+}
+
+// ReloadKeys rereads the key directory so new keys can be rotated in without a restart.
+func (app *Application) ReloadKeys() error {
+	if app.config.JWT.KeysDir == "" {
+		return nil
+	}
+	keySet, err := keys.LoadDir(app.config.JWT.KeysDir, app.config.JWT.ActiveKID)
+	if err != nil {
+		return err
+	}
+	app.keys.Replace(keySet)
+	app.logger.Info("reloaded signing keys", "dir", app.config.JWT.KeysDir)
+	return nil
+}
+
+// refreshPolicy periodically rereads the role and reference tables, so changes
+// made through another instance reach this one without a restart.
+func (app *Application) refreshPolicy() {
+	if app.config.PolicyRefresh <= 0 {
+		return
+	}
+	ticker := time.NewTicker(app.config.PolicyRefresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-app.done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := app.model.LoadPolicy(ctx); err != nil {
+				app.logger.Error("failed to refresh roles", "error", err)
+			}
+			if err := app.model.LoadCatalog(ctx); err != nil {
+				app.logger.Error("failed to refresh catalogs", "error", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// purgeEvents deletes streamed events older than EventsRetention.
+func (app *Application) purgeEvents() {
+	interval := min(app.config.EventsRetention, time.Hour)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-app.done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			deleted, err := app.model.PurgeEvents(ctx, time.Now().Add(-app.config.EventsRetention))
+			if err != nil {
+				app.logger.Error("failed to purge events", "error", err)
+			} else if deleted > 0 {
+				app.logger.Debug("purged events", "deleted", deleted)
+			}
+			cancel()
+		}
+	}
+}
+
+func (app *Application) tokenConfig() handlers.TokenConfig {
+	return handlers.TokenConfig{
+		Keys:      app.keys,
+		Issuer:    app.config.JWT.Issuer,
+		Audience:  app.config.JWT.Audience,
+		ClockSkew: app.config.JWT.ClockSkew,
+	}
+}
+
+// jwtConfig is shared by the REST middleware and the gRPC interceptors.
+func (app *Application) jwtConfig() handlers.JWTConfig {
+	return handlers.JWTConfig{
+		TokenConfig: app.tokenConfig(),
+		IsRevoked:   app.model.IsAccessTokenRevoked,
+		Policy:      app.model.Policy,
+	}
 }
 
 func (app *Application) RegisterHandler(e *echo.Echo, handler *handlers.ServerHandler) {
-	JWTConfig_ := handlers.JWTConfig{
-		SigningKey: app.jwtkey,
-		Skipper: func(c echo.Context) bool {
-			return c.Path() == "/register" ||
-				c.Path() == "/login" ||
-				c.Path() == "/dummyLogin"
-		},
+	JWTConfig_ := app.jwtConfig()
+	JWTConfig_.Skipper = func(c echo.Context) bool {
+		return c.Path() == "/register" ||
+			c.Path() == "/login" ||
+			c.Path() == "/dummyLogin" ||
+			c.Path() == "/token/refresh" ||
+			c.Path() == "/.well-known/jwks.json" ||
+			c.Path() == "/healthz" ||
+			c.Path() == "/readyz" ||
+			c.Path() == "/version" ||
+			c.Path() == "/metrics"
 	}
 
 	authMiddleware := handlers.AuthWithConfig(JWTConfig_)
 
+	// before auth, so 401s and 403s are counted and traced too
+	e.Use(otelecho.Middleware(app.config.Tracing.ServiceName, otelecho.WithSkipper(func(c echo.Context) bool {
+		return c.Path() == "/metrics" || c.Path() == "/healthz" || c.Path() == "/readyz"
+	})))
+	e.Use(app.metrics.Middleware())
+	e.Use(handlers.RequestLogger(app.logger))
+
 	//authGroup := e.Group("")
 	e.Use(authMiddleware)
 
+	e.GET("/metrics", echo.WrapHandler(app.metrics.Handler()))
+
 	handlers.RegisterHandlersMiddleware(e, handler)
 
 }
 
 func (app *Application) Start() error {
 	e := echo.New()
-	handlerLogger := log.New(os.Stdout, "[Handler]: ", log.Ldate|log.Ltime|log.Lshortfile)
 	handler := &handlers.ServerHandler{
-		Model: app.model,
+		Model:   app.model,
+		Config:  &app.config,
+		Ready:   app.Ready,
+		Version: buildVersion(),
 	}
 
-	handler.InitUnexportedVals(app.jwtkey, handlerLogger)
+	handler.InitUnexportedVals(app.tokenConfig(), app.logger)
 
 	e.HideBanner = true
+	e.HidePort = true
 
-	if app.config.env == "development" {
+	if app.config.Env == "development" {
 		e.Debug = true
-		e.Use(middleware.Logger())
 	}
 
 	app.RegisterHandler(e, handler)
@@ -156,70 +292,140 @@ func (app *Application) Start() error {
 	app.server = e
 	app.handler = handler
 
-	app.logger.Printf("starting %s server on %d", app.config.env, app.config.port)
+	address := fmt.Sprintf(":%d", app.config.Port)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("listen: %v", err)
+	}
+	e.Listener = listener
+
+	var grpcListener net.Listener
+	if app.config.GRPCPort != 0 {
+		grpcListener, err = net.Listen("tcp", fmt.Sprintf(":%d", app.config.GRPCPort))
+		if err != nil {
+			listener.Close()
+			return fmt.Errorf("listen grpc: %v", err)
+		}
+		app.grpc = grpcserver.New(app.model, app.jwtConfig(), app.logger)
+	}
+
+	broker, err := stream.NewBroker(app.config.DB.DSN(), app.logger)
+	if err != nil {
+		listener.Close()
+		if grpcListener != nil {
+			grpcListener.Close()
+		}
+		return fmt.Errorf("listen for events: %v", err)
+	}
+	app.stream = broker
+	handler.Stream = broker
+
+	go app.refreshPolicy()
+	go app.purgeEvents()
 
-	address := fmt.Sprintf(":%d", app.config.port)
-	serverErr := make(chan error, 1)
+	app.logger.Info("starting server", "env", app.config.Env, "port", app.config.Port, "grpc_port", app.config.GRPCPort)
+
+	app.serveErr = make(chan error, 2)
 	go func() {
-		if err := e.Start(address); err != nil && err != http.ErrServerClosed {
-			serverErr <- err
-			app.logger.Fatalf("listen: %s\n", err)
+		if err := e.Start(address); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			app.serveErr <- err
 		}
 	}()
+	if app.grpc != nil {
+		go func() {
+			if err := app.grpc.Serve(grpcListener); err != nil {
+				app.serveErr <- fmt.Errorf("grpc: %v", err)
+			}
+		}()
+	}
 
-	select {
-	case err := <-serverErr:
+	app.ready.Store(true)
+	return nil
+}
+
+// Ready reports whether the server accepts requests: the listener is bound and
+// shutdown has not started.
+func (app *Application) Ready() bool {
+	return app.ready.Load()
+}
+
+// Run starts the server and blocks until ctx is cancelled (normally by
+// SIGTERM) or the server fails, then shuts down gracefully.
+func (app *Application) Run(ctx context.Context) error {
+	if err := app.Start(); err != nil {
+		app.model.Close(context.Background())
 		return err
-	case <-time.After(100 * time.Millisecond):
-		app.server = e
-		return nil
 	}
-}
 
-func (app *Application) Stop() error {
-	if app.server == nil {
-		return nil
+	var serveErr error
+	select {
+	case <-ctx.Done():
+		app.logger.Info("shutting down")
+	case serveErr = <-app.serveErr:
+		app.logger.Error("server failed", "error", serveErr)
 	}
+	return errors.Join(serveErr, app.Stop())
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+// Stop stops accepting connections, waits for in-flight requests and then
+// for running transactions, and closes the database, all within
+// ShutdownTimeout. Requests still running at the deadline are cut off.
+func (app *Application) Stop() error {
+	app.stopOnce.Do(func() {
+		app.ready.Store(false)
+		close(app.done)
+
+		ctx, cancel := context.WithTimeout(context.Background(), app.config.ShutdownTimeout)
+		defer cancel()
+
+		var errs []error
+		// event streams never finish on their own, end them before draining
+		if app.stream != nil {
+			if err := app.stream.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("event listener shutdown failed: %v", err))
+			}
+		}
+		if app.server != nil {
+			if err := app.server.Shutdown(ctx); err != nil {
+				app.logger.Warn("graceful shutdown timed out, forcing close")
+				if closeErr := app.server.Close(); closeErr != nil {
+					err = fmt.Errorf("forced close error: %v (original error: %v)", closeErr, err)
+				}
+				errs = append(errs, fmt.Errorf("server shutdown failed: %v", err))
+			}
+		}
 
-	if err := app.server.Shutdown(ctx); err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			app.logger.Println("graceful shutdown timed out, forcing close")
-			if closeErr := app.server.Close(); closeErr != nil {
-				return fmt.Errorf("forced close error: %v (original error: %v)", closeErr, err)
+		if app.grpc != nil {
+			if err := app.grpc.Shutdown(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("grpc shutdown failed: %v", err))
 			}
 		}
-		return fmt.Errorf("server shutdown failed: %v", err)
-	}
 
-	app.logger.Println("server stopped gracefully")
-	return nil
+		if err := app.model.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("database shutdown failed: %v", err))
+		}
 
+		if err := app.flushTraces(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to flush traces: %v", err))
+		}
+
+		app.stopErr = errors.Join(errs...)
+		if app.stopErr == nil {
+			app.logger.Info("server stopped gracefully")
+		}
+	})
+	return app.stopErr
 }
 
-func OpenDB(cfg config) (*sql.DB, error) {
-	cfg.db.dsn = fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		cfg.db.user,
-		cfg.db.password,
-		cfg.db.host,
-		cfg.db.port,
-		cfg.db.name,
-	)
-	db, err := sql.Open("postgres", cfg.db.dsn)
+func OpenDB(cfg config.DB) (*sql.DB, error) {
+	db, err := tracing.OpenDB("postgres", cfg.DSN())
 	if err != nil {
 		return nil, err
 	}
 
-	db.SetMaxOpenConns(cfg.db.maxOpenConns)
-	db.SetMaxIdleConns(cfg.db.maxIdleConns)
-
-	duration, err := time.ParseDuration(cfg.db.maxIdleTime)
-	if err != nil {
-		return nil, err
-	}
-	db.SetConnMaxIdleTime(duration)
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxIdleTime(cfg.MaxIdleTime)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/internal/config"
+)
+
+func TestLoadKeysWithoutDir(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	for env, ok := range map[string]bool{
+		"development": true,
+		"testing":     true,
+		"staging":     false,
+		"production":  false,
+	} {
+		t.Run(env, func(t *testing.T) {
+			cfg := config.Default()
+			cfg.Env = env
+			keySet, err := loadKeys(cfg, logger)
+			if !ok {
+				assert.ErrorContains(t, err, "JWT_KEYS_DIR is required")
+				return
+			}
+			if assert.NoError(t, err) {
+				key, err := keySet.Active()
+				assert.NoError(t, err)
+				assert.True(t, key.CanSign())
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/api/pvzpb"
+	"github.com/wisp167/pvz/internal/config"
+	"github.com/wisp167/pvz/internal/grpcserver"
+	"github.com/wisp167/pvz/internal/handlers"
+	"github.com/wisp167/pvz/internal/logging"
+	"github.com/wisp167/pvz/internal/testdb"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// otelsqlScope is the instrumentation scope of the spans made by tracing.OpenDB.
+const otelsqlScope = "github.com/XSAM/otelsql"
+
+// logBuffer collects the JSON log lines written by the server.
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// requestLine returns the "request" line logged for route.
+func (b *logBuffer) requestLine(route string) map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	scanner := bufio.NewScanner(bytes.NewReader(b.buf.Bytes()))
+	for scanner.Scan() {
+		var line map[string]any
+		if json.Unmarshal(scanner.Bytes(), &line) == nil && line["msg"] == "request" && line["route"] == route {
+			return line
+		}
+	}
+	return nil
+}
+
+// spans returns the ended spans of traceID made by the instrumentation scope.
+func spans(recorder *tracetest.SpanRecorder, traceID trace.TraceID, scope string) []sdktrace.ReadOnlySpan {
+	var out []sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.SpanContext().TraceID() == traceID && span.InstrumentationScope().Name == scope {
+			out = append(out, span)
+		}
+	}
+	return out
+}
+
+// TestTracing makes one call per transport and checks that the HTTP or gRPC
+// server span, the database spans under it and the request log line all
+// carry the caller's trace.
+func TestTracing(t *testing.T) {
+	_, dbCfg := testdb.New(t)
+
+	// before anything is opened: the instrumentations take the provider then
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	cfg := config.Default()
+	cfg.Env = "testing"
+	cfg.DB = dbCfg
+	cfg.AutoMigrate = true
+	cfg.DummyLogin = true
+	app, err := NewApplication(cfg)
+	if !assert.NoError(t, err) {
+		return
+	}
+	t.Cleanup(func() { app.model.Close(context.Background()) })
+
+	logs := &logBuffer{}
+	app.logger = logging.New(logs, config.Log{Level: "info", Format: "json"}, cfg.Env)
+
+	e := echo.New()
+	handler := &handlers.ServerHandler{Model: app.model, Config: &app.config, Ready: app.Ready, Version: buildVersion()}
+	handler.InitUnexportedVals(app.tokenConfig(), app.logger)
+	app.RegisterHandler(e, handler)
+
+	var token string
+
+	t.Run("HTTP", func(t *testing.T) {
+		traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		parentID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+
+		req := httptest.NewRequest(http.MethodPost, "/dummyLogin", strings.NewReader(`{"role":"moderator"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("traceparent", "00-"+traceID.String()+"-"+parentID.String()+"-01")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if !assert.Equal(t, http.StatusOK, rec.Code) {
+			return
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &token))
+
+		server := spans(recorder, traceID, otelecho.ScopeName)
+		if assert.Len(t, server, 1) {
+			assert.Equal(t, trace.SpanKindServer, server[0].SpanKind())
+			assert.Equal(t, parentID, server[0].Parent().SpanID())
+		}
+		queries := spans(recorder, traceID, otelsqlScope)
+		assert.NotEmpty(t, queries, "database spans in the request's trace")
+
+		line := logs.requestLine("/dummyLogin")
+		if assert.NotNil(t, line) {
+			assert.Equal(t, traceID.String(), line["trace_id"])
+		}
+	})
+
+	t.Run("gRPC", func(t *testing.T) {
+		if token == "" {
+			t.Skip("no token from the HTTP call")
+		}
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if !assert.NoError(t, err) {
+			return
+		}
+		srv := grpcserver.New(app.model, app.jwtConfig(), app.logger)
+		go srv.Serve(lis)
+		t.Cleanup(srv.Stop)
+
+		conn, err := grpc.NewClient(lis.Addr().String(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		)
+		if !assert.NoError(t, err) {
+			return
+		}
+		t.Cleanup(func() { conn.Close() })
+
+		ctx, span := otel.Tracer("test").Start(context.Background(), "client")
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		_, err = pvzpb.NewPVZServiceClient(conn).ListPVZ(ctx, &pvzpb.ListPVZRequest{})
+		span.End()
+		assert.NoError(t, err)
+		traceID := span.SpanContext().TraceID()
+
+		serverSpans := func() []string {
+			var names []string
+			for _, s := range spans(recorder, traceID, otelgrpc.ScopeName) {
+				if s.SpanKind() == trace.SpanKindServer {
+					names = append(names, s.Name())
+				}
+			}
+			return names
+		}
+		// the server span ends once the response is on its way
+		assert.Eventually(t, func() bool { return len(serverSpans()) > 0 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"pvz.v1.PVZService/ListPVZ"}, serverSpans())
+		queries := spans(recorder, traceID, otelsqlScope)
+		assert.NotEmpty(t, queries, "database spans in the call's trace")
+
+		line := logs.requestLine("/pvz.v1.PVZService/ListPVZ")
+		if assert.NotNil(t, line) {
+			assert.Equal(t, traceID.String(), line["trace_id"])
+		}
+	})
+}
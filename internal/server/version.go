@@ -0,0 +1,46 @@
+package server
+
+import (
+	"runtime"
+	"runtime/debug"
+
+	"github.com/wisp167/pvz/api"
+)
+
+const version = "1.0.0"
+
+// commit and buildTime are set at build time, the Docker image has no .git:
+//
+//	go build -ldflags "-X github.com/wisp167/pvz/internal/server.commit=$(git rev-parse HEAD)"
+var (
+	commit    string
+	buildTime string
+)
+
+// buildVersion falls back to the VCS stamp go build embeds when run in a checkout.
+func buildVersion() api.Version {
+	v := api.Version{Version: version, Commit: commit, GoVersion: runtime.Version()}
+	built := buildTime
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if v.Commit == "" {
+					v.Commit = setting.Value
+				}
+			case "vcs.time":
+				if built == "" {
+					built = setting.Value
+				}
+			}
+		}
+	}
+	if v.Commit == "" {
+		v.Commit = "unknown"
+	}
+	if built != "" {
+		v.BuildTime = &built
+	}
+	return v
+}
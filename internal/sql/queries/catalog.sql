@@ -0,0 +1,21 @@
+-- name: ListCities :many
+SELECT name, active
+FROM cities
+ORDER BY name;
+
+-- name: UpsertCity :one
+INSERT INTO cities (name, active)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET active = EXCLUDED.active
+RETURNING name, active;
+
+-- name: ListProductTypes :many
+SELECT name, active
+FROM product_types
+ORDER BY name;
+
+-- name: UpsertProductType :one
+INSERT INTO product_types (name, active)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET active = EXCLUDED.active
+RETURNING name, active;
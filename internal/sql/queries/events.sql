@@ -0,0 +1,48 @@
+-- name: LockEventStream :exec
+-- Serialises the transactions publishing events until they commit, so event
+-- ids are handed out in commit order and a client resuming after an id never
+-- misses an event committed later with a lower one.
+SELECT pg_advisory_xact_lock(7305210418);
+
+-- name: PublishEvent :exec
+-- Stores the event and NOTIFYs it; listeners only hear it if the
+-- surrounding transaction commits.
+WITH event AS (
+    INSERT INTO pvz_events (type, pvz_id, city, reception_id, product_id, product_type, actor_id)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)
+    RETURNING id, type, pvz_id, city, reception_id, product_id, product_type, actor_id, created_at
+)
+SELECT pg_notify('pvz_events', json_build_object(
+    'id', id, 'type', type, 'pvzId', pvz_id, 'city', city, 'receptionId', reception_id,
+    'productId', product_id, 'productType', product_type, 'actorId', actor_id, 'createdAt', created_at
+)::text)
+FROM event;
+
+-- name: PublishProductEvents :exec
+-- PublishEvent for every product of a batch, in scan order.
+WITH events AS (
+    INSERT INTO pvz_events (type, pvz_id, city, reception_id, product_id, product_type, actor_id)
+    SELECT sqlc.arg(type), sqlc.arg(pvz_id)::uuid, sqlc.arg(city), p.reception_id, p.id, p.type, sqlc.arg(actor_id)::uuid
+    FROM products p
+    WHERE p.id = ANY(sqlc.arg(product_ids)::uuid[])
+    ORDER BY p.sequence
+    RETURNING id, type, pvz_id, city, reception_id, product_id, product_type, actor_id, created_at
+)
+SELECT pg_notify('pvz_events', json_build_object(
+    'id', id, 'type', type, 'pvzId', pvz_id, 'city', city, 'receptionId', reception_id,
+    'productId', product_id, 'productType', product_type, 'actorId', actor_id, 'createdAt', created_at
+)::text)
+FROM (SELECT * FROM events ORDER BY id) e;
+
+-- name: ListEventsSince :many
+-- Events after the given id for one PVZ or one city, oldest first.
+SELECT id, type, pvz_id, city, reception_id, product_id, product_type, actor_id, created_at
+FROM pvz_events
+WHERE id > sqlc.arg(after_id)
+  AND (sqlc.narg(pvz_id)::uuid IS NULL OR pvz_id = sqlc.narg(pvz_id))
+  AND (sqlc.narg(city)::text IS NULL OR city = sqlc.narg(city))
+ORDER BY id
+LIMIT sqlc.arg(row_limit);
+
+-- name: DeleteEventsBefore :execrows
+DELETE FROM pvz_events WHERE created_at < $1;
@@ -0,0 +1,4 @@
+-- name: Ping :one
+-- Goes through a prepared statement, so /readyz also notices statements
+-- invalidated by a schema change.
+SELECT 1::int AS ok;
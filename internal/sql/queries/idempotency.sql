@@ -0,0 +1,33 @@
+-- name: ClaimIdempotencyKey :one
+-- Inserts the key, or takes over a row that has expired or whose request
+-- never finished; returns no rows while the key is held by a live entry.
+INSERT INTO idempotency_keys (user_id, key, request_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    content_type = NULL,
+    response_body = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW()
+   OR (idempotency_keys.status_code IS NULL AND idempotency_keys.created_at < sqlc.arg(stale_before))
+RETURNING created_at;
+
+-- name: GetIdempotencyKey :one
+SELECT request_hash, status_code, content_type, response_body
+FROM idempotency_keys
+WHERE user_id = $1 AND key = $2;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status_code = $3, content_type = $4, response_body = $5
+WHERE user_id = $1 AND key = $2;
+
+-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE user_id = $1 AND key = $2 AND status_code IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys
+WHERE user_id = $1 AND expires_at < NOW();
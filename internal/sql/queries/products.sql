@@ -6,13 +6,13 @@ WITH current_reception AS (
     FOR SHARE
 ),
 product_insert AS (
-    INSERT INTO products (type, reception_id)
-    SELECT $2, id FROM current_reception
-    RETURNING id, date_time, type, reception_id
+    INSERT INTO products (type, created_by, barcode, sku, weight_grams, length_mm, width_mm, height_mm, reception_id)
+    SELECT $2, $3, $4, $5, $6, $7, $8, $9, id FROM current_reception
+    RETURNING id, date_time, type, reception_id, created_by, barcode, sku, weight_grams, length_mm, width_mm, height_mm
 )
 SELECT * FROM product_insert
 UNION ALL
-SELECT NULL, NULL, NULL, NULL
+SELECT NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL
 WHERE NOT EXISTS (SELECT 1 FROM product_insert);
 
 -- name: DeleteLastProduct :one
@@ -20,11 +20,50 @@ WITH product_to_delete AS (
     SELECT p.id
     FROM products p
     JOIN receptions r ON p.reception_id = r.id
-    WHERE r.pvz_id = $1 AND r.status = 'in_progress'
+    WHERE r.pvz_id = $1 AND r.status = 'in_progress' AND p.deleted_at IS NULL
     ORDER BY p.sequence DESC
     LIMIT 1
     FOR UPDATE SKIP LOCKED
 )
-DELETE FROM products
+UPDATE products
+SET deleted_at = NOW(), deleted_by = $2
 WHERE id IN (SELECT id FROM product_to_delete)
-RETURNING id;
+RETURNING id, type, reception_id;
+
+-- name: ListReceptionProducts :many
+SELECT id, date_time, type, reception_id, created_by, deleted_at, deleted_by,
+    barcode, sku, weight_grams, length_mm, width_mm, height_mm
+FROM products
+WHERE reception_id = $1 AND ($2::boolean OR deleted_at IS NULL)
+ORDER BY sequence DESC;
+
+-- name: LockReceptionInProgress :one
+-- Holds the reception open, like AddProduct's FOR SHARE, while a batch is inserted
+SELECT r.pvz_id, p.city
+FROM receptions r
+JOIN pvz p ON p.id = r.pvz_id
+WHERE r.id = $1 AND r.status = 'in_progress'
+FOR SHARE OF r;
+
+-- name: ListLiveBarcodes :many
+SELECT barcode::text
+FROM products
+WHERE reception_id = $1 AND deleted_at IS NULL AND barcode = ANY(sqlc.arg(barcodes)::text[]);
+
+-- name: AddProducts :many
+-- Multi-row insert of a batch, one element of each array per product. Empty
+-- strings and zeros stand for NULL, since the arrays cannot hold NULLs;
+-- ordering by position keeps the sequence in request order so LIFO deletion
+-- undoes the batch item by item.
+INSERT INTO products (reception_id, created_by, type, barcode, sku, weight_grams, length_mm, width_mm, height_mm)
+SELECT sqlc.arg(reception_id)::uuid, sqlc.arg(created_by)::uuid,
+    (sqlc.arg(types)::text[])[i],
+    NULLIF((sqlc.arg(barcodes)::text[])[i], ''),
+    NULLIF((sqlc.arg(skus)::text[])[i], ''),
+    NULLIF((sqlc.arg(weights_grams)::int[])[i], 0),
+    NULLIF((sqlc.arg(lengths_mm)::int[])[i], 0),
+    NULLIF((sqlc.arg(widths_mm)::int[])[i], 0),
+    NULLIF((sqlc.arg(heights_mm)::int[])[i], 0)
+FROM generate_subscripts(sqlc.arg(types)::text[], 1) AS i
+ORDER BY i
+RETURNING id, date_time, type, reception_id, created_by, barcode, sku, weight_grams, length_mm, width_mm, height_mm, sequence;
@@ -6,11 +6,25 @@ INSERT INTO pvz (
 )
 RETURNING id, registration_date, city;
 
+-- name: ImportPVZ :one
+-- Keeps the id and registration date of a PVZ moved from another system;
+-- a PVZ imported twice is left as is.
+INSERT INTO pvz (
+    id, registration_date, city
+) VALUES (
+    COALESCE(sqlc.narg(id)::uuid, uuid_generate_v4()),
+    COALESCE(sqlc.narg(registration_date)::timestamptz, NOW()),
+    sqlc.arg(city)
+)
+ON CONFLICT (id) DO NOTHING
+RETURNING id, registration_date, city;
+
 
 -- name: GetPVZsWithReceptions :many
 WITH pvz_paginated AS (
     SELECT id, registration_date, city
     FROM pvz
+    WHERE deactivated_at IS NULL AND ($5::text = '' OR city = $5::text)
     ORDER BY registration_date DESC, id
     LIMIT $3 OFFSET (($4 - 1) * $3)
 ),
@@ -20,14 +34,28 @@ reception_data AS (
         r.id AS reception_id,
         r.date_time,
         r.status,
+        r.created_by,
+        r.closed_by,
         (SELECT json_agg(json_build_object(
             'id', p.id,
             'dateTime', p.date_time,
             'type', p.type,
-            'receptionId', p.reception_id
+            'receptionId', p.reception_id,
+            'createdBy', p.created_by,
+            'deletedAt', p.deleted_at,
+            'deletedBy', p.deleted_by,
+            'barcode', p.barcode,
+            'sku', p.sku,
+            'weightGrams', p.weight_grams,
+            'dimensions', CASE WHEN p.length_mm IS NULL THEN NULL ELSE json_build_object(
+                'lengthMm', p.length_mm,
+                'widthMm', p.width_mm,
+                'heightMm', p.height_mm
+            ) END
         ) ORDER BY p.sequence DESC)
         FROM products p
         WHERE p.reception_id = r.id
+            AND ($6::boolean OR p.deleted_at IS NULL)
         ) AS products
     FROM receptions r
     WHERE 
@@ -45,10 +73,83 @@ SELECT
                 'id', rd.reception_id,
                 'dateTime', rd.date_time,
                 'status', rd.status,
-                'pvzId', rd.pvz_id
+                'pvzId', rd.pvz_id,
+                'createdBy', rd.created_by,
+                'closedBy', rd.closed_by
             ),
             'products', rd.products
         )) FROM reception_data rd WHERE rd.pvz_id = p.id),
         '[]'::json
     )::text AS receptions_json
 FROM pvz_paginated p;
+
+-- name: GetPVZCity :one
+SELECT city FROM pvz
+WHERE id = $1;
+
+-- name: GetPVZWithReceptions :one
+SELECT
+    p.id AS pvz_id,
+    p.registration_date,
+    p.city,
+    p.deactivated_at,
+    COALESCE(
+        (SELECT json_agg(json_build_object(
+            'reception', json_build_object(
+                'id', r.id,
+                'dateTime', r.date_time,
+                'status', r.status,
+                'pvzId', r.pvz_id,
+                'createdBy', r.created_by,
+                'closedBy', r.closed_by
+            ),
+            'products', (
+                SELECT json_agg(json_build_object(
+                    'id', pr.id,
+                    'dateTime', pr.date_time,
+                    'type', pr.type,
+                    'receptionId', pr.reception_id,
+                    'createdBy', pr.created_by,
+                    'deletedAt', pr.deleted_at,
+                    'deletedBy', pr.deleted_by,
+                    'barcode', pr.barcode,
+                    'sku', pr.sku,
+                    'weightGrams', pr.weight_grams,
+                    'dimensions', CASE WHEN pr.length_mm IS NULL THEN NULL ELSE json_build_object(
+                        'lengthMm', pr.length_mm,
+                        'widthMm', pr.width_mm,
+                        'heightMm', pr.height_mm
+                    ) END
+                ) ORDER BY pr.sequence DESC)
+                FROM products pr
+                WHERE pr.reception_id = r.id
+                    AND ($2::boolean OR pr.deleted_at IS NULL)
+            )
+        ) ORDER BY r.date_time DESC)
+        FROM receptions r
+        WHERE r.pvz_id = p.id),
+        '[]'::json
+    )::text AS receptions_json
+FROM pvz p
+WHERE p.id = $1;
+
+-- name: UpdatePVZ :one
+UPDATE pvz
+SET city = $2, updated_at = NOW()
+WHERE id = $1 AND deactivated_at IS NULL
+RETURNING id, registration_date, city;
+
+-- name: LockPVZ :one
+SELECT deactivated_at FROM pvz
+WHERE id = $1
+FOR UPDATE;
+
+-- name: LockActivePVZ :one
+SELECT city FROM pvz
+WHERE id = $1 AND deactivated_at IS NULL
+FOR SHARE;
+
+-- name: DeactivatePVZ :execrows
+UPDATE pvz
+SET deactivated_at = NOW(), deactivated_by = $2, updated_at = NOW()
+WHERE id = $1 AND deactivated_at IS NULL;
@@ -6,10 +6,10 @@ WITH existing_reception AS (
     FOR UPDATE SKIP LOCKED
 ),
 new_reception AS (
-    INSERT INTO receptions (pvz_id, status)
-    SELECT $1, 'in_progress'
+    INSERT INTO receptions (pvz_id, status, created_by)
+    SELECT $1, 'in_progress', $2
     WHERE NOT EXISTS (SELECT 1 FROM existing_reception)
-    RETURNING id, date_time, pvz_id, status
+    RETURNING id, date_time, pvz_id, status, created_by, closed_by
 )
 SELECT * FROM new_reception
 UNION ALL
@@ -17,10 +17,19 @@ SELECT
     r.id, 
     r.date_time, 
     r.pvz_id, 
-    r.status
+    r.status,
+    r.created_by,
+    r.closed_by
 FROM existing_reception er
 JOIN receptions r ON er.id = r.id;
 
+-- name: CountOpenReceptionsByCity :many
+SELECT p.city, COUNT(*) AS open_receptions
+FROM receptions r
+JOIN pvz p ON p.id = r.pvz_id
+WHERE r.status = 'in_progress'
+GROUP BY p.city;
+
 -- name: HasOpenReceptions :one
 SELECT EXISTS (
     SELECT 1 FROM receptions 
@@ -29,7 +38,7 @@ SELECT EXISTS (
 
 -- name: CloseReception :one
 WITH reception_to_close AS (
-    SELECT id, date_time, pvz_id, status 
+    SELECT id, date_time, pvz_id, status, created_by, closed_by
     FROM receptions
     WHERE receptions.pvz_id = $1 AND receptions.status = 'in_progress'
     ORDER BY date_time DESC
@@ -38,14 +47,60 @@ WITH reception_to_close AS (
 ),
 updated_reception AS (
     UPDATE receptions r
-    SET status = 'close', updated_at = NOW()
+    SET status = 'closed', closed_by = $2, closed_at = NOW(), updated_at = NOW()
     FROM reception_to_close rtc
     WHERE r.id = rtc.id
-    RETURNING r.id, r.date_time, r.pvz_id, r.status
+    RETURNING r.id, r.date_time, r.pvz_id, r.status, r.created_by, r.closed_by
 )
 SELECT * FROM updated_reception
 UNION ALL
-SELECT id, date_time, pvz_id, status
+SELECT id, date_time, pvz_id, status, created_by, closed_by
 FROM reception_to_close
 WHERE NOT EXISTS (SELECT 1 FROM updated_reception)
 LIMIT 1;
+
+-- name: GetReception :one
+SELECT id, date_time, pvz_id, status, created_by, closed_by
+FROM receptions
+WHERE id = $1;
+
+-- name: GetCurrentReception :one
+SELECT id, date_time, pvz_id, status, created_by, closed_by
+FROM receptions
+WHERE pvz_id = $1 AND status = 'in_progress'
+ORDER BY date_time DESC
+LIMIT 1;
+
+-- name: ListReceptions :many
+-- Keyset pagination: the cursor is the (date_time, id) of the last row of the previous page
+SELECT id, date_time, pvz_id, status, created_by, closed_by
+FROM receptions
+WHERE pvz_id = $1
+    AND ($2::text = '' OR status = $2::text)
+    AND date_time BETWEEN COALESCE($3, '-infinity'::timestamp)
+                      AND COALESCE($4, 'infinity'::timestamp)
+    AND (NOT $5::boolean OR (date_time, id) < ($6::timestamptz, $7::uuid))
+ORDER BY date_time DESC, id DESC
+LIMIT $8;
+
+-- name: GetReceptionForUpdate :one
+SELECT id, date_time, pvz_id, status, created_by, closed_by, closed_at
+FROM receptions
+WHERE id = $1
+FOR UPDATE;
+
+-- name: SetReceptionStatus :one
+UPDATE receptions
+SET status = $2, closed_by = $3, closed_at = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING id, date_time, pvz_id, status, created_by, closed_by;
+
+-- name: AddReceptionTransition :exec
+INSERT INTO reception_transitions (reception_id, from_status, to_status, reason, actor_id)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListReceptionTransitions :many
+SELECT id, reception_id, from_status, to_status, reason, actor_id, created_at
+FROM reception_transitions
+WHERE reception_id = $1
+ORDER BY id;
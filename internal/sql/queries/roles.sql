@@ -0,0 +1,23 @@
+-- name: ListRolePermissions :many
+SELECT r.name AS role, rp.permission, rp.scope
+FROM roles r
+LEFT JOIN role_permissions rp ON rp.role = r.name
+ORDER BY r.name, rp.permission;
+
+-- name: ListRoles :many
+SELECT name, description
+FROM roles
+ORDER BY name;
+
+-- name: UpsertRole :exec
+INSERT INTO roles (name, description)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET description = EXCLUDED.description;
+
+-- name: DeleteRolePermissions :exec
+DELETE FROM role_permissions
+WHERE role = $1;
+
+-- name: AddRolePermission :exec
+INSERT INTO role_permissions (role, permission, scope)
+VALUES ($1, $2, $3);
@@ -0,0 +1,26 @@
+-- name: AssignStaff :one
+INSERT INTO pvz_staff (pvz_id, user_id, assigned_by)
+SELECT $1, $2, $3
+WHERE EXISTS (
+    SELECT 1 FROM users
+    WHERE id = $2 AND role = 'employee'
+)
+ON CONFLICT (pvz_id, user_id) DO UPDATE SET assigned_by = EXCLUDED.assigned_by, assigned_at = NOW()
+RETURNING pvz_id, user_id, assigned_by, assigned_at;
+
+-- name: UnassignStaff :execrows
+DELETE FROM pvz_staff
+WHERE pvz_id = $1 AND user_id = $2;
+
+-- name: ListStaff :many
+SELECT s.pvz_id, s.user_id, u.email, s.assigned_by, s.assigned_at
+FROM pvz_staff s
+JOIN users u ON u.id = s.user_id
+WHERE s.pvz_id = $1
+ORDER BY s.assigned_at;
+
+-- name: IsStaffAssigned :one
+SELECT EXISTS (
+    SELECT 1 FROM pvz_staff
+    WHERE pvz_id = $1 AND user_id = $2
+) AS assigned;
@@ -93,7 +93,7 @@ WITH reception_to_close AS (
     FOR UPDATE NOWAIT
 )
 UPDATE receptions r
-SET status = 'close', updated_at = NOW()
+SET status = 'closed', updated_at = NOW()
 FROM reception_to_close rtc
 WHERE r.id = rtc.id
 RETURNING r.id, r.date_time, r.pvz_id, r.status;
@@ -1,9 +1,37 @@
 -- name: CreateUser :one
+INSERT INTO users (email, password_hash, role, city) 
+VALUES ($1, $2, $3, $4)
+RETURNING id, email, role, city;
+
+-- name: GetUserByEmail :one
+SELECT id, email, password_hash, role, city 
+FROM users 
+WHERE email = $1;
+
+-- name: UpdateUserPasswordHash :exec
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetUserByID :one
+SELECT id, email, role, city 
+FROM users 
+WHERE id = $1;
+
+-- name: GetOrCreateUser :one
 INSERT INTO users (email, password_hash, role) 
-VALUES ($1, md5($2), $3)
-RETURNING id, email, role;
+VALUES ($1, $2, $3)
+ON CONFLICT (email) DO UPDATE SET updated_at = NOW()
+RETURNING id, email, role, city;
 
--- name: GetUserByCredentials :one
-SELECT role 
+-- name: ListUsers :many
+SELECT id, email, role, city 
 FROM users 
-WHERE email = $1 AND password_hash = md5($2);
+ORDER BY created_at, id
+LIMIT $1 OFFSET (($2::int - 1) * $1);
+
+-- name: UpdateUser :one
+UPDATE users
+SET role = $2, city = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, role, city;
@@ -0,0 +1,181 @@
+// Package stream fans the PVZ events published with NOTIFY out to the
+// Server-Sent Events clients connected to this instance.
+package stream
+
+import (
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/data"
+)
+
+const (
+	// SubscriptionBuffer is how many events a subscriber may fall behind
+	// before it is dropped. A dropped client reconnects with Last-Event-ID
+	// and catches up from the database.
+	SubscriptionBuffer = 256
+
+	minReconnectInterval = time.Second
+	maxReconnectInterval = time.Minute
+	pingInterval         = 90 * time.Second
+)
+
+// Broker listens on data.EventsChannel with its own connection and passes
+// every event to the subscriptions whose filter matches it.
+type Broker struct {
+	listener *pq.Listener
+	logger   *slog.Logger
+	done     chan struct{}
+
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+// Subscription receives the events of one filter until it is closed by
+// Unsubscribe, by the broker falling behind or losing its connection, or by
+// Close. The events channel is closed then.
+type Subscription struct {
+	filter data.EventFilter
+	events chan api.PVZEvent
+}
+
+// Events delivers the subscribed events in the order they were committed.
+func (s *Subscription) Events() <-chan api.PVZEvent {
+	return s.events
+}
+
+// NewBroker connects to dsn and starts listening. The connection is
+// re-established in the background when it breaks.
+func NewBroker(dsn string, logger *slog.Logger) (*Broker, error) {
+	logger = logger.With("component", "stream")
+	listener := pq.NewListener(dsn, minReconnectInterval, maxReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Warn("event listener connection", "event", event, "error", err)
+		}
+	})
+	if err := listener.Listen(data.EventsChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	b := &Broker{
+		listener: listener,
+		logger:   logger,
+		done:     make(chan struct{}),
+		subs:     make(map[*Subscription]struct{}),
+	}
+	go b.run()
+	return b, nil
+}
+
+// Subscribe starts delivering the events matching filter. Callers must
+// Unsubscribe when they are done.
+func (b *Broker) Subscribe(filter data.EventFilter) *Subscription {
+	sub := &Subscription{filter: filter, events: make(chan api.PVZEvent, SubscriptionBuffer)}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(sub.events)
+		return sub
+	}
+	b.subs[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe stops sub; it is safe to call more than once.
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.drop(sub)
+}
+
+// Close ends every subscription, so the streams being served return, and
+// stops listening.
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	for sub := range b.subs {
+		b.drop(sub)
+	}
+	b.mu.Unlock()
+
+	err := b.listener.Close()
+	<-b.done
+	return err
+}
+
+func (b *Broker) run() {
+	defer close(b.done)
+
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case n, ok := <-b.listener.Notify:
+			if !ok {
+				return
+			}
+			if n == nil {
+				// the connection was re-established and whatever was sent in
+				// between is lost; clients catch up when they reconnect
+				b.logger.Warn("event listener reconnected, dropping subscribers")
+				b.dropAll()
+				continue
+			}
+			b.dispatch(n.Extra)
+		case <-ping.C:
+			// a dead connection is only noticed when something is sent on it
+			go b.listener.Ping()
+		}
+	}
+}
+
+func (b *Broker) dispatch(payload string) {
+	var event api.PVZEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		b.logger.Error("failed to decode event", "error", err, "payload", payload)
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if !sub.filter.Matches(uuid.UUID(event.PvzId), event.City) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			b.drop(sub)
+		}
+	}
+}
+
+func (b *Broker) dropAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		b.drop(sub)
+	}
+}
+
+// drop must be called with b.mu held.
+func (b *Broker) drop(sub *Subscription) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	close(sub.events)
+}
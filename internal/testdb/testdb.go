@@ -0,0 +1,57 @@
+// Package testdb gives unit tests a database of their own on the Postgres
+// configured by the DATABASE_* environment, as in docker compose's test
+// service. Tests calling New are skipped when DATABASE_HOST is not set.
+package testdb
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/wisp167/pvz/internal/config"
+)
+
+var counter atomic.Int64
+
+// New creates an empty database that is dropped when the test ends and
+// returns a connection to it together with its settings.
+func New(t testing.TB) (*sql.DB, config.DB) {
+	t.Helper()
+	if os.Getenv("DATABASE_HOST") == "" {
+		t.Skip("DATABASE_HOST is not set, no test database")
+	}
+
+	cfg, err := config.Load(flag.NewFlagSet("testdb", flag.ContinueOnError), nil)
+	if err != nil {
+		t.Fatalf("testdb: config: %v", err)
+	}
+
+	admin, err := sql.Open("postgres", cfg.DB.DSN())
+	if err != nil {
+		t.Fatalf("testdb: %v", err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	dbCfg := cfg.DB
+	dbCfg.Name = fmt.Sprintf("%s_test_%d_%d_%d", cfg.DB.Name, os.Getpid(), time.Now().UnixNano()%1_000_000, counter.Add(1))
+	if _, err := admin.Exec(`CREATE DATABASE "` + dbCfg.Name + `"`); err != nil {
+		t.Fatalf("testdb: create %s: %v", dbCfg.Name, err)
+	}
+
+	db, err := sql.Open("postgres", dbCfg.DSN())
+	if err != nil {
+		t.Fatalf("testdb: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		if _, err := admin.Exec(`DROP DATABASE IF EXISTS "` + dbCfg.Name + `" WITH (FORCE)`); err != nil {
+			t.Errorf("testdb: drop %s: %v", dbCfg.Name, err)
+		}
+	})
+	return db, dbCfg
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMainProcess is main run in a subprocess by TestExitCodes.
+func TestMainProcess(t *testing.T) {
+	raw := os.Getenv("PVZ_MAIN_ARGS")
+	if raw == "" {
+		t.Skip("run by TestExitCodes")
+	}
+	var args []string
+	if err := json.Unmarshal([]byte(raw), &args); err != nil {
+		t.Fatal(err)
+	}
+	os.Args = append([]string{"pvz-service"}, args...)
+	main()
+	os.Exit(0)
+}
+
+func TestExitCodes(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		want int
+	}{
+		{[]string{"help"}, 0},
+		{[]string{"-h"}, 0},
+		{[]string{"migrate", "-h"}, 0},
+		{[]string{"seed", "-h"}, 0},
+		{[]string{"user", "create", "-h"}, 0},
+		{[]string{"frobnicate"}, 1},
+		{[]string{"migrate"}, 1},
+		{[]string{"migrate", "down", "all"}, 1},
+		{[]string{"seed", "-env", "production"}, 1},
+		{[]string{"seed", "-pvz", "0"}, 1},
+		{[]string{"user", "create"}, 1},
+		{[]string{"user", "create", "-nope"}, 1},
+	} {
+		raw, _ := json.Marshal(tc.args)
+		cmd := exec.Command(os.Args[0], "-test.run=^TestMainProcess$")
+		// .env may turn on dummy login, which production does not allow
+		cmd.Env = append(os.Environ(), "PVZ_MAIN_ARGS="+string(raw), "DUMMY_LOGIN=")
+		err := cmd.Run()
+
+		code := 0
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			code = exitErr.ExitCode()
+		} else if !assert.NoError(t, err, tc.args) {
+			continue
+		}
+		assert.Equal(t, tc.want, code, "%v", tc.args)
+	}
+}
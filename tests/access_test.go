@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/api"
+)
+
+func TestRolePermissions(t *testing.T) {
+	auditorToken := authenticateUser(t, "auditor")
+	adminToken := authenticateUser(t, "admin")
+	employeeToken := authenticateUser(t, "employee")
+
+	t.Run("Auditor can read but not write", func(t *testing.T) {
+		resp := makeRequest(t, "GET", apiURL+"/pvz", auditorToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = makeRequest(t, "POST", apiURL+"/pvz", auditorToken, []byte(`{"city":"Москва"}`))
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Only admins manage users and roles", func(t *testing.T) {
+		resp := makeRequest(t, "GET", apiURL+"/roles", adminToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var roles []api.Role
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&roles))
+		names := make([]string, 0, len(roles))
+		for _, role := range roles {
+			names = append(names, role.Name)
+		}
+		assert.Contains(t, names, "regional_manager")
+
+		resp = makeRequest(t, "GET", apiURL+"/users", employeeToken, nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("New role without code change", func(t *testing.T) {
+		role := "viewer_" + GenerateRandomStringSample(6)
+		resp := makeRequest(t, "PUT", apiURL+"/roles/"+role, adminToken,
+			[]byte(`{"description":"test","permissions":[{"permission":"pvz:read","scope":"all"}]}`))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		viewerToken := authenticateUser(t, role)
+		resp = makeRequest(t, "GET", apiURL+"/pvz", viewerToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp = makeRequest(t, "GET", apiURL+"/roles", viewerToken, nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp = makeRequest(t, "PUT", apiURL+"/roles/"+role, adminToken,
+			[]byte(`{"permissions":[{"permission":"pvz:fly","scope":"all"}]}`))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Regional manager is limited to their city", func(t *testing.T) {
+		client, err := api.NewClientWithResponses(apiURL)
+		assert.NoError(t, err)
+
+		email := types.Email(GenerateRandomStringSample(8) + "@pvz.local")
+		password := GenerateRandomStringSample(12)
+		city := "Казань"
+
+		createResp, err := client.PostUsersWithResponse(context.Background(), api.PostUsersJSONRequestBody{
+			Email:    email,
+			Password: password,
+			Role:     "regional_manager",
+			City:     &city,
+		}, func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+adminToken)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+		loginResp, err := client.PostLoginWithResponse(context.Background(), api.PostLoginJSONRequestBody{
+			Email:    email,
+			Password: password,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, loginResp.StatusCode())
+		managerToken := string(loginResp.JSON200.Token)
+
+		pvz := createPVZ(t, managerToken, city)
+		assert.Equal(t, city, pvz.City)
+
+		resp := makeRequest(t, "POST", apiURL+"/pvz", managerToken, []byte(`{"city":"Москва"}`))
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		moderatorToken := authenticateUser(t, "moderator")
+		moscow := createPVZ(t, moderatorToken, "Москва")
+		resp = makeRequest(t, "GET", apiURL+"/pvz/"+moscow.Id.String()+"/staff", managerToken, nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		resp = makeRequest(t, "GET", apiURL+"/pvz/"+pvz.Id.String()+"/staff", managerToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = makeRequest(t, "GET", apiURL+"/pvz?limit=30", managerToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var list []struct {
+			PVZ api.PVZ `json:"pvz"`
+		}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+		for _, item := range list {
+			assert.Equal(t, city, item.PVZ.City)
+		}
+	})
+}
+
+func TestAdminConfig(t *testing.T) {
+	adminToken := authenticateUser(t, "admin")
+	employeeToken := authenticateUser(t, "employee")
+
+	resp := makeRequest(t, "GET", apiURL+"/admin/config", employeeToken, nil)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = makeRequest(t, "GET", apiURL+"/admin/config", adminToken, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var cfg struct {
+		Port int `json:"port"`
+		DB   struct {
+			Password string `json:"password"`
+		} `json:"db"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&cfg))
+	assert.NotZero(t, cfg.Port)
+	assert.Equal(t, "[redacted]", cfg.DB.Password)
+}
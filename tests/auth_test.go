@@ -2,7 +2,10 @@ package tests
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/oapi-codegen/runtime/types"
@@ -19,6 +22,7 @@ func TestDummyLogin(t *testing.T) {
 		{"Employee login", "employee", http.StatusOK},
 		{"Moderator login", "moderator", http.StatusOK},
 		{"Invalid role", "invalid", http.StatusBadRequest},
+		{"Privileged role", "admin", http.StatusBadRequest},
 	}
 
 	for _, tt := range tests {
@@ -30,7 +34,7 @@ func TestDummyLogin(t *testing.T) {
 			case "moderator":
 				roleEnum = api.PostDummyLoginJSONBodyRoleModerator
 			default:
-				roleEnum = "invalid"
+				roleEnum = api.PostDummyLoginJSONBodyRole(tt.role)
 			}
 
 			client, err := api.NewClientWithResponses(apiURL)
@@ -82,3 +86,121 @@ func TestRegisterAndLogin(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusUnauthorized, invalidLoginResp.StatusCode())
 }
+
+func TestRefreshAndLogout(t *testing.T) {
+	client, err := api.NewClientWithResponses(apiURL)
+	assert.NoError(t, err)
+
+	email := types.Email(GenerateRandomStringSample(8) + "@gmail.com")
+	password := GenerateRandomStringSample(10)
+
+	registerResp, err := client.PostRegisterWithResponse(context.Background(), api.PostRegisterJSONRequestBody{
+		Email:    email,
+		Password: password,
+		Role:     api.PostRegisterJSONBodyRoleEmployee,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, registerResp.StatusCode())
+
+	loginResp, err := client.PostLoginWithResponse(context.Background(), api.PostLoginJSONRequestBody{
+		Email:    email,
+		Password: password,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode())
+	assert.NotNil(t, loginResp.JSON200)
+	first := *loginResp.JSON200
+
+	refreshResp, err := client.PostTokenRefreshWithResponse(context.Background(), api.PostTokenRefreshJSONRequestBody{
+		RefreshToken: first.RefreshToken,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, refreshResp.StatusCode())
+	assert.NotNil(t, refreshResp.JSON200)
+	second := *refreshResp.JSON200
+	assert.NotEqual(t, first.RefreshToken, second.RefreshToken)
+
+	// Reusing a rotated token revokes the whole family
+	reuseResp, err := client.PostTokenRefreshWithResponse(context.Background(), api.PostTokenRefreshJSONRequestBody{
+		RefreshToken: first.RefreshToken,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, reuseResp.StatusCode())
+
+	reuseResp, err = client.PostTokenRefreshWithResponse(context.Background(), api.PostTokenRefreshJSONRequestBody{
+		RefreshToken: second.RefreshToken,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, reuseResp.StatusCode())
+
+	// A logged out access token is rejected
+	resp := makeRequest(t, "POST", apiURL+"/logout", second.Token, nil)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp = makeRequest(t, "GET", apiURL+"/pvz", second.Token, nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	loginResp, err = client.PostLoginWithResponse(context.Background(), api.PostLoginJSONRequestBody{
+		Email:    email,
+		Password: password,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode())
+	third := *loginResp.JSON200
+
+	// Another user cannot log out these sessions with a leaked refresh token
+	body, _ := json.Marshal(map[string]string{"refreshToken": third.RefreshToken})
+	resp = makeRequest(t, "POST", apiURL+"/logout", authenticateUser(t, "moderator"), body)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	refreshResp, err = client.PostTokenRefreshWithResponse(context.Background(), api.PostTokenRefreshJSONRequestBody{
+		RefreshToken: third.RefreshToken,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, refreshResp.StatusCode())
+
+	// An empty chunked body means no refresh token
+	req, err := http.NewRequest("POST", apiURL+"/logout", strings.NewReader(""))
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+third.Token)
+	req.TransferEncoding = []string{"chunked"}
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestJWKS(t *testing.T) {
+	client, err := api.NewClientWithResponses(apiURL)
+	assert.NoError(t, err)
+
+	resp, err := client.GetWellKnownJwksJsonWithResponse(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.NotNil(t, resp.JSON200)
+
+	token := authenticateUser(t, "employee")
+	header, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[0])
+	assert.NoError(t, err)
+	var jose struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	assert.NoError(t, json.Unmarshal(header, &jose))
+
+	var found bool
+	for _, key := range resp.JSON200.Keys {
+		if key.Kid == jose.Kid {
+			found = true
+			assert.Equal(t, jose.Alg, key.Alg)
+		}
+	}
+	assert.True(t, found, "token kid must be published in JWKS")
+}
+
+func TestRejectsUnsignedToken(t *testing.T) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"role":"moderator","sub":"00000000-0000-0000-0000-000000000000","iss":"pvz","aud":"pvz","exp":4102444800,"iat":1700000000,"jti":"00000000-0000-0000-0000-000000000001"}`))
+
+	resp := makeRequest(t, "GET", apiURL+"/pvz", header+"."+claims+".", nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/api"
+)
+
+// openEventStream connects to an SSE endpoint and decodes its events until
+// the test ends.
+func openEventStream(t *testing.T, streamURL, token string, lastEventID int64) <-chan api.PVZEvent {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", streamURL, nil)
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if lastEventID > 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatInt(lastEventID, 10))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if !assert.NoError(t, err) || !assert.Equal(t, http.StatusOK, resp.StatusCode) {
+		t.FailNow()
+	}
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	events := make(chan api.PVZEvent, 16)
+	go func() {
+		defer resp.Body.Close()
+		defer close(events)
+		var id, eventType string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				eventType = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				var event api.PVZEvent
+				if json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event) != nil ||
+					strconv.FormatInt(event.Id, 10) != id || string(event.Type) != eventType {
+					return
+				}
+				events <- event
+			}
+		}
+	}()
+	return events
+}
+
+func nextEvent(t *testing.T, events <-chan api.PVZEvent) api.PVZEvent {
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("event stream closed")
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event within 5s")
+	}
+	return api.PVZEvent{}
+}
+
+func TestEventStream(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Казань")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+	pvzURL := fmt.Sprintf("%s/pvz/%s/events", apiURL, pvz.Id.String())
+	cityURL := fmt.Sprintf("%s/cities/%s/events", apiURL, url.PathEscape("Казань"))
+
+	pvzEvents := openEventStream(t, pvzURL, moderatorToken, 0)
+	cityEvents := openEventStream(t, cityURL, moderatorToken, 0)
+
+	reception := createReception(t, employeeToken, pvz.Id.String())
+	product := addProduct(t, employeeToken, pvz.Id.String(), productTypes[0])
+	resp := makeRequest(t, "POST", fmt.Sprintf("%s/pvz/%s/delete_last_product", apiURL, pvz.Id.String()), employeeToken, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	closeReception(t, employeeToken, pvz.Id.String())
+
+	wantTypes := []api.PVZEventType{api.ReceptionOpened, api.ProductAdded, api.ProductDeleted, api.ReceptionClosed}
+	var received []api.PVZEvent
+
+	t.Run("PVZ stream", func(t *testing.T) {
+		for _, want := range wantTypes {
+			event := nextEvent(t, pvzEvents)
+			assert.Equal(t, want, event.Type)
+			assert.Equal(t, *pvz.Id, event.PvzId)
+			assert.Equal(t, *reception.Id, event.ReceptionId)
+			assert.Equal(t, "Казань", event.City)
+			received = append(received, event)
+		}
+		assert.Equal(t, *product.Id, *received[1].ProductId)
+		assert.Equal(t, productTypes[0], *received[2].ProductType)
+		assert.Equal(t, tokenSubject(t, employeeToken), *received[0].ActorId)
+	})
+
+	t.Run("City stream", func(t *testing.T) {
+		// other PVZs of the city may interleave
+		var ids []int64
+		for len(ids) < len(received) {
+			event := nextEvent(t, cityEvents)
+			if event.PvzId == *pvz.Id {
+				ids = append(ids, event.Id)
+			}
+		}
+		for i, event := range received {
+			assert.Equal(t, event.Id, ids[i])
+		}
+	})
+
+	t.Run("Resume with Last-Event-ID", func(t *testing.T) {
+		if !assert.Len(t, received, len(wantTypes)) {
+			return
+		}
+		events := openEventStream(t, pvzURL, moderatorToken, received[0].Id)
+		for _, want := range received[1:] {
+			assert.Equal(t, want.Id, nextEvent(t, events).Id)
+		}
+	})
+
+	t.Run("Transitions", func(t *testing.T) {
+		events := openEventStream(t, pvzURL, moderatorToken, 0)
+		transition := func(token, receptionID, name string) {
+			resp := makeRequest(t, "POST", fmt.Sprintf("%s/receptions/%s/%s", apiURL, receptionID, name), token, nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+
+		cancelled := createReception(t, employeeToken, pvz.Id.String())
+		transition(employeeToken, cancelled.Id.String(), "cancel")
+		verified := createReception(t, employeeToken, pvz.Id.String())
+		closeReception(t, employeeToken, pvz.Id.String())
+		transition(moderatorToken, verified.Id.String(), "verify")
+
+		for _, want := range []struct {
+			eventType   api.PVZEventType
+			receptionID string
+		}{
+			{api.ReceptionOpened, cancelled.Id.String()},
+			{api.ReceptionCancelled, cancelled.Id.String()},
+			{api.ReceptionOpened, verified.Id.String()},
+			{api.ReceptionClosed, verified.Id.String()},
+			{api.ReceptionVerified, verified.Id.String()},
+		} {
+			event := nextEvent(t, events)
+			assert.Equal(t, want.eventType, event.Type)
+			assert.Equal(t, want.receptionID, event.ReceptionId.String())
+			assert.Equal(t, "Казань", event.City)
+		}
+	})
+
+	t.Run("Access", func(t *testing.T) {
+		resp := makeRequest(t, "GET", pvzURL, "", nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		// a regional manager without a city cannot watch one
+		resp = makeRequest(t, "GET", cityURL, authenticateUser(t, "regional_manager"), nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp = makeRequest(t, "GET", apiURL+"/cities/Атлантида/events", moderatorToken, nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
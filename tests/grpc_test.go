@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/api/pvzpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const grpcAddr = "localhost:9090"
+
+func grpcClient(t *testing.T) *grpc.ClientConn {
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func TestGRPC(t *testing.T) {
+	conn := grpcClient(t)
+	client := pvzpb.NewPVZServiceClient(conn)
+
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	t.Run("Health", func(t *testing.T) {
+		resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{
+			Service: pvzpb.PVZService_ServiceDesc.ServiceName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	})
+
+	t.Run("Needs a token", func(t *testing.T) {
+		_, err := client.ListPVZ(context.Background(), &pvzpb.ListPVZRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Same role checks as REST", func(t *testing.T) {
+		_, err := client.CreatePVZ(withToken(employeeToken), &pvzpb.CreatePVZRequest{City: "Москва"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Reception workflow", func(t *testing.T) {
+		pvz, err := client.CreatePVZ(withToken(moderatorToken), &pvzpb.CreatePVZRequest{City: "Москва"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Москва", pvz.GetCity())
+
+		// not assigned to the PVZ yet
+		_, err = client.CreateReception(withToken(employeeToken), &pvzpb.CreateReceptionRequest{PvzId: pvz.GetId()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		assignStaff(t, moderatorToken, employeeToken, pvz.GetId())
+
+		reception, err := client.CreateReception(withToken(employeeToken), &pvzpb.CreateReceptionRequest{PvzId: pvz.GetId()})
+		assert.NoError(t, err)
+		assert.Equal(t, pvzpb.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, reception.GetStatus())
+
+		product, err := client.AddProduct(withToken(employeeToken), &pvzpb.AddProductRequest{
+			PvzId:   pvz.GetId(),
+			Type:    "электроника",
+			Barcode: "grpc-" + GenerateRandomStringSample(8),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, reception.GetId(), product.GetReceptionId())
+
+		_, err = client.AddProduct(withToken(employeeToken), &pvzpb.AddProductRequest{
+			PvzId:   pvz.GetId(),
+			Type:    "электроника",
+			Barcode: product.GetBarcode(),
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		_, err = client.DeleteLastProduct(withToken(employeeToken), &pvzpb.DeleteLastProductRequest{PvzId: pvz.GetId()})
+		assert.NoError(t, err)
+
+		closed, err := client.CloseLastReception(withToken(employeeToken), &pvzpb.CloseLastReceptionRequest{PvzId: pvz.GetId()})
+		assert.NoError(t, err)
+		assert.Equal(t, pvzpb.ReceptionStatus_RECEPTION_STATUS_CLOSED, closed.GetStatus())
+
+		list, err := client.ListPVZ(withToken(moderatorToken), &pvzpb.ListPVZRequest{Limit: 30})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, list.GetItems())
+	})
+}
@@ -16,6 +16,7 @@ func TestIntegrationWorkflow(t *testing.T) {
 	assert.Equal(t, "Казань", string(pvz.City))
 
 	employeeToken := authenticateUser(t, "employee")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
 
 	reception := createReception(t, employeeToken, pvz.Id.String())
 	assert.Equal(t, "in_progress", string(reception.Status))
@@ -28,7 +29,7 @@ func TestIntegrationWorkflow(t *testing.T) {
 
 	// 6. Close the reception
 	closedReception := closeReception(t, employeeToken, pvz.Id.String())
-	assert.Equal(t, "close", string(closedReception.Status))
+	assert.Equal(t, "closed", string(closedReception.Status))
 
 	// 7. Verify data through GET /pvz
 	resp := makeRequest(t, "GET", apiURL+"/pvz", moderatorToken, nil)
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/api"
 )
 
 func TestProductOperations(t *testing.T) {
@@ -15,6 +16,7 @@ func TestProductOperations(t *testing.T) {
 
 	// Create a PVZ and reception first
 	pvz := createPVZ(t, moderatorToken, "Санкт-Петербург")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
 	createReception(t, employeeToken, pvz.Id.String())
 
 	// Test adding products
@@ -23,6 +25,7 @@ func TestProductOperations(t *testing.T) {
 		t.Run("Add "+productType, func(t *testing.T) {
 			product := addProduct(t, employeeToken, pvz.Id.String(), productType)
 			assert.Equal(t, productType, string(product.Type))
+			assert.NotNil(t, product.CreatedBy)
 			products = append(products, product.Id.String())
 		})
 	}
@@ -35,6 +38,22 @@ func TestProductOperations(t *testing.T) {
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
 	})
 
+	// Deleted products stay in the table as tombstones
+	t.Run("Deleted product is hidden by default", func(t *testing.T) {
+		live := listProducts(t, moderatorToken, pvz.Id.String(), false)
+		assert.Len(t, live, len(products)-1)
+		for _, product := range live {
+			assert.Nil(t, product.DeletedAt)
+		}
+
+		all := listProducts(t, moderatorToken, pvz.Id.String(), true)
+		assert.Len(t, all, len(products))
+		deleted := all[0] // newest first
+		assert.Equal(t, products[len(products)-1], deleted.Id.String())
+		assert.NotNil(t, deleted.DeletedAt)
+		assert.Equal(t, tokenSubject(t, employeeToken), *deleted.DeletedBy)
+	})
+
 	// Test cannot delete when no products
 	t.Run("Delete from empty reception", func(t *testing.T) {
 		// Create new empty reception
@@ -61,3 +80,173 @@ func TestProductOperations(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+// listProducts returns the products of every reception of pvzID, newest first.
+func listProducts(t *testing.T, token string, pvzID string, includeDeleted bool) []api.Product {
+	resp := makeRequest(t, "GET",
+		fmt.Sprintf("%s/pvz?limit=30&includeDeleted=%t", apiURL, includeDeleted),
+		token, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var list []struct {
+		PVZ        api.PVZ `json:"pvz"`
+		Receptions []struct {
+			Products []api.Product `json:"products"`
+		} `json:"receptions"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+
+	var products []api.Product
+	for _, item := range list {
+		if item.PVZ.Id.String() != pvzID {
+			continue
+		}
+		for _, reception := range item.Receptions {
+			products = append(products, reception.Products...)
+		}
+	}
+	return products
+}
+
+func TestProductManifestFields(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Москва")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+	createReception(t, employeeToken, pvz.Id.String())
+
+	barcode := "4600000" + GenerateRandomStringSample(6)
+	scan := func(body map[string]interface{}) *http.Response {
+		body["pvzId"] = pvz.Id.String()
+		body["type"] = productTypes[0]
+		raw, _ := json.Marshal(body)
+		return makeRequest(t, "POST", apiURL+"/products", employeeToken, raw)
+	}
+
+	t.Run("Add product with details", func(t *testing.T) {
+		resp := scan(map[string]interface{}{
+			"barcode":     barcode,
+			"sku":         "SKU-1",
+			"weightGrams": 1200,
+			"dimensions":  map[string]int{"lengthMm": 300, "widthMm": 200, "heightMm": 100},
+		})
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var product api.Product
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&product))
+		assert.Equal(t, barcode, *product.Barcode)
+		assert.Equal(t, "SKU-1", *product.Sku)
+		assert.Equal(t, 1200, *product.WeightGrams)
+		assert.Equal(t, api.Dimensions{LengthMm: 300, WidthMm: 200, HeightMm: 100}, *product.Dimensions)
+	})
+
+	t.Run("Duplicate barcode", func(t *testing.T) {
+		resp := scan(map[string]interface{}{"barcode": barcode})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		// products without a barcode are never duplicates
+		resp = scan(map[string]interface{}{})
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Undone scan frees the barcode", func(t *testing.T) {
+		resp := scan(map[string]interface{}{"barcode": barcode + "-2"})
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp = makeRequest(t, "POST",
+			fmt.Sprintf("%s/pvz/%s/delete_last_product", apiURL, pvz.Id.String()),
+			employeeToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = scan(map[string]interface{}{"barcode": barcode + "-2"})
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Invalid weight", func(t *testing.T) {
+		resp := scan(map[string]interface{}{"weightGrams": 0})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestProductBatch(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Казань")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+	reception := createReception(t, employeeToken, pvz.Id.String())
+	batchURL := fmt.Sprintf("%s/receptions/%s/products:batch", apiURL, reception.Id.String())
+
+	barcode := "4601000" + GenerateRandomStringSample(6)
+	addProduct(t, employeeToken, pvz.Id.String(), productTypes[0])
+
+	t.Run("Per-item results", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"products": []map[string]interface{}{
+				{"type": productTypes[0], "barcode": barcode},
+				{"type": productTypes[1], "barcode": barcode}, // duplicate within the batch
+				{"type": "космос"},
+				{"type": productTypes[2], "weightGrams": 0},
+				{"type": productTypes[1]},
+			},
+		})
+		resp := makeRequest(t, "POST", batchURL, employeeToken, body)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var batch api.ProductBatchResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&batch))
+		assert.Len(t, batch.Results, 5)
+		statuses := make([]api.ProductBatchResultStatus, 0, len(batch.Results))
+		for i, result := range batch.Results {
+			assert.Equal(t, i, result.Index)
+			statuses = append(statuses, result.Status)
+		}
+		assert.Equal(t, []api.ProductBatchResultStatus{api.Created, api.Rejected, api.Rejected, api.Rejected, api.Created}, statuses)
+		assert.Equal(t, reception.Id.String(), batch.Results[0].Product.ReceptionId.String())
+	})
+
+	t.Run("Batch keeps LIFO order", func(t *testing.T) {
+		// newest first: the last item of the batch, then the first, then the single scan
+		live := listProducts(t, moderatorToken, pvz.Id.String(), false)
+		assert.Len(t, live, 3)
+		assert.Equal(t, productTypes[1], live[0].Type)
+		assert.Equal(t, barcode, *live[1].Barcode)
+
+		resp := makeRequest(t, "POST",
+			fmt.Sprintf("%s/pvz/%s/delete_last_product", apiURL, pvz.Id.String()),
+			employeeToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		live = listProducts(t, moderatorToken, pvz.Id.String(), false)
+		assert.Len(t, live, 2)
+		assert.Equal(t, barcode, *live[0].Barcode)
+	})
+
+	t.Run("Batch size limit", func(t *testing.T) {
+		products := make([]map[string]interface{}, 501)
+		for i := range products {
+			products[i] = map[string]interface{}{"type": productTypes[0]}
+		}
+		body, _ := json.Marshal(map[string]interface{}{"products": products})
+		resp := makeRequest(t, "POST", batchURL, employeeToken, body)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	})
+
+	t.Run("Only assigned staff", func(t *testing.T) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"products": []map[string]interface{}{{"type": productTypes[0]}},
+		})
+		resp := makeRequest(t, "POST", batchURL, authenticateUser(t, "employee"), body)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Closed reception", func(t *testing.T) {
+		closeReception(t, employeeToken, pvz.Id.String())
+		body, _ := json.Marshal(map[string]interface{}{
+			"products": []map[string]interface{}{{"type": productTypes[0]}},
+		})
+		resp := makeRequest(t, "POST", batchURL, employeeToken, body)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+}
@@ -4,10 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/api"
 )
 
 func TestPVZCreation(t *testing.T) {
@@ -59,3 +62,110 @@ func TestGetPVZs(t *testing.T) {
 	resp = makeRequest(t, "GET", url, moderatorToken, nil)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestPVZLifecycle(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Москва")
+	pvzURL := apiURL + "/pvz/" + pvz.Id.String()
+
+	t.Run("Get by ID", func(t *testing.T) {
+		resp := makeRequest(t, "GET", pvzURL, employeeToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var detail api.PVZWithReceptions
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&detail))
+		assert.Equal(t, *pvz.Id, *detail.Pvz.Id)
+		assert.Empty(t, detail.Receptions)
+
+		resp = makeRequest(t, "GET", apiURL+"/pvz/"+uuid.NewString(), employeeToken, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Correct the city", func(t *testing.T) {
+		resp := makeRequest(t, "PATCH", pvzURL, employeeToken, []byte(`{"city":"Казань"}`))
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp = makeRequest(t, "PATCH", pvzURL, moderatorToken, []byte(`{"city":"Новосибирск"}`))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp = makeRequest(t, "PATCH", pvzURL, moderatorToken, []byte(`{"city":"Казань"}`))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var updated api.PVZ
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&updated))
+		assert.Equal(t, "Казань", updated.City)
+	})
+
+	t.Run("Deactivate", func(t *testing.T) {
+		assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+		createReception(t, employeeToken, pvz.Id.String())
+
+		resp := makeRequest(t, "DELETE", pvzURL, moderatorToken, nil)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		closeReception(t, employeeToken, pvz.Id.String())
+
+		resp = makeRequest(t, "DELETE", pvzURL, moderatorToken, nil)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = makeRequest(t, "DELETE", pvzURL, moderatorToken, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		// no new receptions at a deactivated PVZ
+		body, _ := json.Marshal(map[string]string{"pvzId": pvz.Id.String()})
+		resp = makeRequest(t, "POST", apiURL+"/receptions", employeeToken, body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp = makeRequest(t, "GET", pvzURL, moderatorToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var detail api.PVZWithReceptions
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&detail))
+		assert.NotNil(t, detail.Pvz.DeactivatedAt)
+	})
+}
+
+func TestCatalogs(t *testing.T) {
+	adminToken := authenticateUser(t, "admin")
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	city := "Город-" + GenerateRandomStringSample(6)
+	cityURL := apiURL + "/cities/" + url.PathEscape(city)
+	pvzBody, _ := json.Marshal(map[string]string{"city": city})
+
+	resp := makeRequest(t, "POST", apiURL+"/pvz", moderatorToken, pvzBody)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = makeRequest(t, "PUT", cityURL, moderatorToken, []byte(`{"active":true}`))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = makeRequest(t, "PUT", cityURL, adminToken, []byte(`{"active":true}`))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = makeRequest(t, "POST", apiURL+"/pvz", moderatorToken, pvzBody)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp = makeRequest(t, "GET", apiURL+"/cities", employeeToken, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var cities []api.CatalogEntry
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&cities))
+	assert.Contains(t, cities, api.CatalogEntry{Name: city, Active: true})
+
+	resp = makeRequest(t, "PUT", cityURL, adminToken, []byte(`{"active":false}`))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = makeRequest(t, "POST", apiURL+"/pvz", moderatorToken, pvzBody)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	t.Run("Unknown product type", func(t *testing.T) {
+		pvz := createPVZ(t, moderatorToken, "Москва")
+		assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+		createReception(t, employeeToken, pvz.Id.String())
+
+		body, _ := json.Marshal(map[string]string{"pvzId": pvz.Id.String(), "type": "мебель"})
+		resp := makeRequest(t, "POST", apiURL+"/products", employeeToken, body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
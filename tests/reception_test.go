@@ -1,11 +1,15 @@
 package tests
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/api"
 )
 
 func TestReceptionWorkflow(t *testing.T) {
@@ -15,13 +19,16 @@ func TestReceptionWorkflow(t *testing.T) {
 	// Test creating a reception
 	t.Run("Create reception", func(t *testing.T) {
 		pvz := createPVZ(t, moderatorToken, "Москва")
+		assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
 		reception := createReception(t, employeeToken, pvz.Id.String())
 		assert.Equal(t, "in_progress", string(reception.Status))
+		assert.NotNil(t, reception.CreatedBy)
 	})
 
 	// Test cannot create another reception while one is open
 	t.Run("Only one open reception", func(t *testing.T) {
 		pvz := createPVZ(t, moderatorToken, "Москва")
+		assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
 		createReception(t, employeeToken, pvz.Id.String())
 		reqBody := map[string]string{"pvzId": pvz.Id.String()}
 		body, _ := json.Marshal(reqBody)
@@ -33,6 +40,7 @@ func TestReceptionWorkflow(t *testing.T) {
 	// Test adding products
 	t.Run("Add products to reception", func(t *testing.T) {
 		pvz := createPVZ(t, moderatorToken, "Москва")
+		assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
 		createReception(t, employeeToken, pvz.Id.String())
 		for _, productType := range productTypes {
 			product := addProduct(t, employeeToken, pvz.Id.String(), productType)
@@ -43,17 +51,307 @@ func TestReceptionWorkflow(t *testing.T) {
 	// Test closing reception
 	t.Run("Close reception", func(t *testing.T) {
 		pvz := createPVZ(t, moderatorToken, "Москва")
+		assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
 		createReception(t, employeeToken, pvz.Id.String())
 		closedReception := closeReception(t, employeeToken, pvz.Id.String())
-		assert.Equal(t, "close", string(closedReception.Status))
+		assert.Equal(t, "closed", string(closedReception.Status))
+		assert.NotNil(t, closedReception.ClosedBy)
 	})
 
 	// Test can create new reception after closing
 	t.Run("Create new reception after closing", func(t *testing.T) {
 		pvz := createPVZ(t, moderatorToken, "Москва")
+		assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
 		createReception(t, employeeToken, pvz.Id.String())
 		closeReception(t, employeeToken, pvz.Id.String())
 		reception := createReception(t, employeeToken, pvz.Id.String())
 		assert.Equal(t, "in_progress", string(reception.Status))
 	})
 }
+
+func TestStaffAssignment(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Казань")
+	reqBody := map[string]string{"pvzId": pvz.Id.String()}
+	body, _ := json.Marshal(reqBody)
+
+	// Not assigned yet
+	resp := makeRequest(t, "POST", apiURL+"/receptions", employeeToken, body)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+	createReception(t, employeeToken, pvz.Id.String())
+
+	// Unassigned employees lose access again
+	staffURL := fmt.Sprintf("%s/pvz/%s/staff/%s", apiURL, pvz.Id.String(), tokenSubject(t, employeeToken))
+	resp = makeRequest(t, "DELETE", staffURL, moderatorToken, nil)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp = makeRequest(t, "POST", fmt.Sprintf("%s/pvz/%s/close_last_reception", apiURL, pvz.Id.String()), employeeToken, nil)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestReceptionLookup(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Казань")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+	pvzURL := apiURL + "/pvz/" + pvz.Id.String()
+
+	t.Run("No current reception", func(t *testing.T) {
+		resp := makeRequest(t, "GET", pvzURL+"/receptions/current", employeeToken, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	// three closed receptions and one open
+	var receptionIDs []string
+	for i := 0; i < 3; i++ {
+		reception := createReception(t, employeeToken, pvz.Id.String())
+		receptionIDs = append(receptionIDs, reception.Id.String())
+		closeReception(t, employeeToken, pvz.Id.String())
+	}
+	current := createReception(t, employeeToken, pvz.Id.String())
+	product := addProduct(t, employeeToken, pvz.Id.String(), productTypes[0])
+
+	t.Run("Current reception", func(t *testing.T) {
+		resp := makeRequest(t, "GET", pvzURL+"/receptions/current", employeeToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var detail api.ReceptionWithProducts
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&detail))
+		assert.Equal(t, *current.Id, *detail.Reception.Id)
+		assert.Len(t, detail.Products, 1)
+		assert.Equal(t, *product.Id, *detail.Products[0].Id)
+	})
+
+	t.Run("Reception by ID", func(t *testing.T) {
+		resp := makeRequest(t, "GET", apiURL+"/receptions/"+current.Id.String(), moderatorToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = makeRequest(t, "GET", apiURL+"/receptions/"+uuid.NewString(), moderatorToken, nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		// city-scoped pvz:read only covers the manager's own city
+		resp = makeRequest(t, "GET", apiURL+"/receptions/"+current.Id.String(), createUser(t, "regional_manager", "Казань"), nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = makeRequest(t, "GET", apiURL+"/receptions/"+current.Id.String(), createUser(t, "regional_manager", "Москва"), nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Paginated history", func(t *testing.T) {
+		var seen []string
+		cursor := ""
+		for {
+			url := pvzURL + "/receptions?status=closed&limit=2"
+			if cursor != "" {
+				url += "&cursor=" + cursor
+			}
+			resp := makeRequest(t, "GET", url, employeeToken, nil)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var page api.ReceptionPage
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+			for _, reception := range page.Items {
+				assert.Equal(t, "closed", string(reception.Status))
+				seen = append(seen, reception.Id.String())
+			}
+			if page.NextCursor == nil {
+				break
+			}
+			cursor = *page.NextCursor
+		}
+
+		// newest first
+		assert.Equal(t, []string{receptionIDs[2], receptionIDs[1], receptionIDs[0]}, seen)
+
+		resp := makeRequest(t, "GET", pvzURL+"/receptions?cursor=garbage", employeeToken, nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Москва")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+
+	post := func(url, key string, body []byte) *http.Response {
+		req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+		assert.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+employeeToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", key)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	receptionBody, _ := json.Marshal(map[string]string{"pvzId": pvz.Id.String()})
+	receptionKey := uuid.NewString()
+
+	first := post(apiURL+"/receptions", receptionKey, receptionBody)
+	assert.Equal(t, http.StatusCreated, first.StatusCode)
+	var reception api.Reception
+	assert.NoError(t, json.NewDecoder(first.Body).Decode(&reception))
+
+	t.Run("Retry replays the reception", func(t *testing.T) {
+		resp := post(apiURL+"/receptions", receptionKey, receptionBody)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "true", resp.Header.Get("Idempotent-Replayed"))
+
+		var replayed api.Reception
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&replayed))
+		assert.Equal(t, *reception.Id, *replayed.Id)
+	})
+
+	t.Run("Retry does not duplicate the product", func(t *testing.T) {
+		productBody, _ := json.Marshal(map[string]string{"pvzId": pvz.Id.String(), "type": productTypes[0]})
+		productKey := uuid.NewString()
+
+		first := post(apiURL+"/products", productKey, productBody)
+		assert.Equal(t, http.StatusCreated, first.StatusCode)
+		retry := post(apiURL+"/products", productKey, productBody)
+		assert.Equal(t, http.StatusCreated, retry.StatusCode)
+
+		assert.Len(t, listProducts(t, employeeToken, pvz.Id.String(), false), 1)
+	})
+
+	t.Run("Different payload with the same key", func(t *testing.T) {
+		other := createPVZ(t, moderatorToken, "Москва")
+		otherBody, _ := json.Marshal(map[string]string{"pvzId": other.Id.String()})
+		assignStaff(t, moderatorToken, employeeToken, other.Id.String())
+
+		resp := post(apiURL+"/receptions", receptionKey, otherBody)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("Keys are per user", func(t *testing.T) {
+		otherEmployee := authenticateUser(t, "employee")
+		assignStaff(t, moderatorToken, otherEmployee, pvz.Id.String())
+
+		req, _ := http.NewRequest("POST", apiURL+"/receptions", bytes.NewReader(receptionBody))
+		req.Header.Set("Authorization", "Bearer "+otherEmployee)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", receptionKey)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		// a fresh request for this user: the reception is already open
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Empty(t, resp.Header.Get("Idempotent-Replayed"))
+	})
+
+	t.Run("Anonymous endpoints refuse the key", func(t *testing.T) {
+		for path, body := range map[string]any{
+			"/dummyLogin":    map[string]string{"role": "employee"},
+			"/register":      map[string]string{"email": uuid.NewString() + "@example.com", "password": "password", "role": "employee"},
+			"/login":         map[string]string{"email": "nobody@example.com", "password": "password"},
+			"/token/refresh": map[string]string{"refreshToken": "garbage"},
+		} {
+			payload, _ := json.Marshal(body)
+			req, _ := http.NewRequest("POST", apiURL+path, bytes.NewReader(payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Idempotency-Key", uuid.NewString())
+			resp, err := http.DefaultClient.Do(req)
+			if assert.NoError(t, err) {
+				assert.Equal(t, http.StatusBadRequest, resp.StatusCode, path)
+			}
+		}
+	})
+}
+
+func TestReceptionTransitions(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Санкт-Петербург")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+
+	transition := func(token, receptionID, name string, body []byte) *http.Response {
+		return makeRequest(t, "POST", fmt.Sprintf("%s/receptions/%s/%s", apiURL, receptionID, name), token, body)
+	}
+	status := func(resp *http.Response) string {
+		var reception api.Reception
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&reception))
+		return string(reception.Status)
+	}
+	reopenBody, _ := json.Marshal(map[string]string{"reason": "пропущена коробка"})
+
+	t.Run("Cancel an open reception", func(t *testing.T) {
+		reception := createReception(t, employeeToken, pvz.Id.String())
+
+		resp := transition(moderatorToken, reception.Id.String(), "cancel", nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp = transition(employeeToken, reception.Id.String(), "cancel", nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "cancelled", status(resp))
+
+		// a cancelled reception is final
+		resp = transition(moderatorToken, reception.Id.String(), "reopen", reopenBody)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Reopen and verify a closed reception", func(t *testing.T) {
+		reception := createReception(t, employeeToken, pvz.Id.String())
+		closeReception(t, employeeToken, pvz.Id.String())
+		id := reception.Id.String()
+
+		resp := transition(employeeToken, id, "reopen", reopenBody)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp = transition(moderatorToken, id, "reopen", []byte(`{"reason": "  "}`))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp = transition(moderatorToken, id, "reopen", reopenBody)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "in_progress", status(resp))
+
+		// products can be added again, then it is closed and verified
+		addProduct(t, employeeToken, pvz.Id.String(), productTypes[0])
+		closeReception(t, employeeToken, pvz.Id.String())
+
+		resp = transition(moderatorToken, id, "verify", nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "verified", status(resp))
+
+		resp = transition(moderatorToken, id, "verify", nil)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		resp = makeRequest(t, "GET", apiURL+"/receptions/"+id+"/transitions", employeeToken, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var history []api.ReceptionTransition
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&history))
+
+		var steps []string
+		for _, step := range history {
+			steps = append(steps, step.FromStatus+"->"+step.ToStatus)
+		}
+		assert.Equal(t, []string{
+			"in_progress->closed",
+			"closed->in_progress",
+			"in_progress->closed",
+			"closed->verified",
+		}, steps)
+		assert.Equal(t, "пропущена коробка", *history[1].Reason)
+		assert.Equal(t, tokenSubject(t, moderatorToken), *history[1].ActorId)
+	})
+
+	t.Run("Reopen needs a free PVZ", func(t *testing.T) {
+		reception := createReception(t, employeeToken, pvz.Id.String())
+		closeReception(t, employeeToken, pvz.Id.String())
+		createReception(t, employeeToken, pvz.Id.String())
+
+		resp := transition(moderatorToken, reception.Id.String(), "reopen", reopenBody)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Unknown reception", func(t *testing.T) {
+		resp := transition(moderatorToken, uuid.NewString(), "verify", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -4,6 +4,8 @@ package tests
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"math/rand"
 	"net/http"
 	"strconv"
@@ -12,6 +14,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/wisp167/pvz/api"
 )
@@ -20,6 +23,10 @@ const (
 	apiURL = "http://localhost:8080"
 )
 
+// bootstrapAdminToken belongs to an admin created directly in the database,
+// like `user create` does; it creates the users of the other privileged roles
+var bootstrapAdminToken string
+
 var (
 	validCities  = []string{"Москва", "Санкт-Петербург", "Казань"}
 	productTypes = []string{"электроника", "одежда", "обувь"}
@@ -57,7 +64,7 @@ func makeRequest(t *testing.T, method, url, token string, body []byte) *http.Res
 	if token != "" {
 		req.Header.Set("Authorization", "Bearer "+token)
 	}
-	if method == "POST" || method == "PUT" {
+	if method == "POST" || method == "PUT" || method == "PATCH" {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
@@ -69,15 +76,11 @@ func makeRequest(t *testing.T, method, url, token string, body []byte) *http.Res
 
 // Helper function to authenticate and get a token
 func authenticateUser(t *testing.T, role string) string {
-	var roleEnum api.PostDummyLoginJSONBodyRole
-	switch role {
-	case "employee":
-		roleEnum = api.PostDummyLoginJSONBodyRoleEmployee
-	case "moderator":
-		roleEnum = api.PostDummyLoginJSONBodyRoleModerator
-	default:
-		t.Fatalf("invalid role: %s", role)
+	// /dummyLogin only hands out employee and moderator tokens
+	if role != "employee" && role != "moderator" {
+		return createUser(t, role, "")
 	}
+	roleEnum := api.PostDummyLoginJSONBodyRole(role)
 
 	client, err := api.NewClientWithResponses(apiURL)
 	assert.NoError(t, err)
@@ -92,25 +95,56 @@ func authenticateUser(t *testing.T, role string) string {
 	return string(*resp.JSON200)
 }
 
-// Helper to create a PVZ
-func createPVZ(t *testing.T, token string, city string) *api.PVZ {
-	var cityEnum api.PVZCity
-	switch city {
-	case "Москва":
-		cityEnum = api.PVZCity("Москва")
-	case "Санкт-Петербург":
-		cityEnum = api.PVZCity("Санкт-Петербург")
-	case "Казань":
-		cityEnum = api.PVZCity("Казань")
-	default:
-		t.Fatalf("invalid city: %s", city)
+// createUser creates a user with any role through POST /users, as the
+// bootstrap admin, and returns its access token.
+func createUser(t *testing.T, role string, city string) string {
+	client, err := api.NewClientWithResponses(apiURL)
+	assert.NoError(t, err)
+
+	email, password := Generate_Username_Password(0)
+	req := api.PostUsersJSONRequestBody{
+		Email:    types.Email(email + "@example.com"),
+		Password: password,
+		Role:     role,
+	}
+	if city != "" {
+		req.City = &city
 	}
+	resp, err := client.PostUsersWithResponse(context.Background(), req, withBearer(bootstrapAdminToken))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode())
+
+	return login(t, string(req.Email), password)
+}
+
+func login(t *testing.T, email, password string) string {
+	client, err := api.NewClientWithResponses(apiURL)
+	assert.NoError(t, err)
+
+	resp, err := client.PostLoginWithResponse(context.Background(), api.PostLoginJSONRequestBody{
+		Email:    types.Email(email),
+		Password: password,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.NotNil(t, resp.JSON200)
+	return string(resp.JSON200.Token)
+}
+
+func withBearer(token string) api.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
 
+// Helper to create a PVZ
+func createPVZ(t *testing.T, token string, city string) *api.PVZ {
 	client, err := api.NewClientWithResponses(apiURL)
 	assert.NoError(t, err)
 
 	resp, err := client.PostPvzWithResponse(context.Background(), api.PostPvzJSONRequestBody{
-		City: cityEnum,
+		City: city,
 	}, func(ctx context.Context, req *http.Request) error {
 		req.Header.Set("Authorization", "Bearer "+token)
 		return nil
@@ -151,21 +185,9 @@ func addProduct(t *testing.T, token string, pvzID string, productType string) *a
 	pvzUUID, err := uuid.Parse(pvzID)
 	assert.NoError(t, err)
 
-	var typeEnum api.PostProductsJSONBodyType
-	switch productType {
-	case "электроника":
-		typeEnum = api.PostProductsJSONBodyType("электроника")
-	case "одежда":
-		typeEnum = api.PostProductsJSONBodyType("одежда")
-	case "обувь":
-		typeEnum = api.PostProductsJSONBodyType("обувь")
-	default:
-		t.Fatalf("invalid product type: %s", productType)
-	}
-
 	resp, err := client.PostProductsWithResponse(context.Background(), api.PostProductsJSONRequestBody{
 		PvzId: pvzUUID,
-		Type:  typeEnum,
+		Type:  productType,
 	}, func(ctx context.Context, req *http.Request) error {
 		req.Header.Set("Authorization", "Bearer "+token)
 		return nil
@@ -195,3 +217,39 @@ func closeReception(t *testing.T, token string, pvzID string) *api.Reception {
 
 	return resp.JSON200
 }
+
+// tokenSubject returns the user ID carried in the sub claim of token
+func tokenSubject(t *testing.T, token string) uuid.UUID {
+	parts := strings.Split(token, ".")
+	assert.Len(t, parts, 3)
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.NoError(t, err)
+
+	var claims struct {
+		Sub string `json:"sub"`
+	}
+	assert.NoError(t, json.Unmarshal(payload, &claims))
+
+	userID, err := uuid.Parse(claims.Sub)
+	assert.NoError(t, err)
+	return userID
+}
+
+// Helper to assign the employee behind employeeToken to a PVZ
+func assignStaff(t *testing.T, moderatorToken, employeeToken string, pvzID string) {
+	client, err := api.NewClientWithResponses(apiURL)
+	assert.NoError(t, err)
+
+	pvzUUID, err := uuid.Parse(pvzID)
+	assert.NoError(t, err)
+
+	resp, err := client.PostPvzPvzIdStaffWithResponse(context.Background(), pvzUUID, api.PostPvzPvzIdStaffJSONRequestBody{
+		UserId: tokenSubject(t, employeeToken),
+	}, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+moderatorToken)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode())
+}
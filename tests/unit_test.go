@@ -1,31 +1,79 @@
 package tests
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"testing"
-	"time"
 
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/wisp167/pvz/api"
+	"github.com/wisp167/pvz/internal/config"
+	"github.com/wisp167/pvz/internal/logging"
 	"github.com/wisp167/pvz/internal/server"
 )
 
 var app *server.Application
 
 func setup() (*server.Application, error) {
+	// the tests sign in through /dummyLogin
+	os.Setenv("DUMMY_LOGIN", "true")
+
 	app, err := server.SetupApplication()
 	if err != nil {
 		return nil, err
 	}
 
-	go func() {
-		if err := app.Start(); err != nil {
-			panic(err)
-		}
-	}()
-
-	time.Sleep(1 * time.Second)
+	// Start returns once the listener is bound, no need to wait
+	if err := app.Start(); err != nil {
+		return nil, err
+	}
 	return app, nil
 }
 
+// bootstrapAdmin creates an admin and logs it in.
+func bootstrapAdmin() (string, error) {
+	cfg, err := config.Load(flag.NewFlagSet("bootstrap", flag.ContinueOnError), nil)
+	if err != nil {
+		return "", err
+	}
+	model, err := server.OpenModels(cfg, logging.New(io.Discard, cfg.Log, cfg.Env))
+	if err != nil {
+		return "", err
+	}
+	defer model.Close(context.Background())
+
+	email, password := Generate_Username_Password(0)
+	user, err := model.CreateUser(context.Background(), api.PostUsersJSONBody{
+		Email:    types.Email(email + "@example.com"),
+		Password: password,
+		Role:     "admin",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	client, err := api.NewClientWithResponses(apiURL)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.PostLoginWithResponse(context.Background(), api.PostLoginJSONRequestBody{
+		Email:    types.Email(user.Email),
+		Password: password,
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.JSON200 == nil {
+		return "", fmt.Errorf("bootstrap admin login: %s", resp.Status())
+	}
+	return string(resp.JSON200.Token), nil
+}
+
 func teardown(app *server.Application) {
 	if err := app.Stop(); err != nil {
 		panic(err)
@@ -38,9 +86,74 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		panic(err)
 	}
+	bootstrapAdminToken, err = bootstrapAdmin()
+	if err != nil {
+		teardown(app)
+		panic(err)
+	}
 
 	code := m.Run()
 	teardown(app)
-	time.Sleep(1 * time.Second)
 	os.Exit(code)
 }
+
+func TestHealthEndpoints(t *testing.T) {
+	client, err := api.NewClientWithResponses(apiURL)
+	assert.NoError(t, err)
+
+	health, err := client.GetHealthzWithResponse(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, health.StatusCode())
+
+	ready, err := client.GetReadyzWithResponse(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, ready.StatusCode())
+	if assert.NotNil(t, ready.JSON200) && assert.NotNil(t, ready.JSON200.Checks) {
+		assert.Equal(t, "ok", (*ready.JSON200.Checks)["migrations"])
+	}
+	assert.True(t, app.Ready())
+
+	version, err := client.GetVersionWithResponse(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, version.StatusCode())
+	if assert.NotNil(t, version.JSON200) {
+		assert.NotEmpty(t, version.JSON200.Version)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	moderatorToken := authenticateUser(t, "moderator")
+	employeeToken := authenticateUser(t, "employee")
+
+	pvz := createPVZ(t, moderatorToken, "Москва")
+	assignStaff(t, moderatorToken, employeeToken, pvz.Id.String())
+	createReception(t, employeeToken, pvz.Id.String())
+	addProduct(t, employeeToken, pvz.Id.String(), "электроника")
+
+	resp := makeRequest(t, "GET", apiURL+"/metrics", "", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+
+	text := string(body)
+	assert.Contains(t, text, `pvz_http_requests_total{method="POST",route="/receptions",status="201"}`)
+	assert.Contains(t, text, `pvz_http_request_duration_seconds_count{method="POST",route="/pvz/:pvzId/staff"}`)
+	assert.Contains(t, text, `pvz_receptions_opened_total{city="Москва"}`)
+	assert.Contains(t, text, `pvz_products_added_total{city="Москва",type="электроника"}`)
+	assert.Contains(t, text, `pvz_open_receptions{city="Москва"}`)
+	assert.Contains(t, text, "go_sql_open_connections")
+}
+
+func TestRequestID(t *testing.T) {
+	req, err := http.NewRequest("GET", apiURL+"/healthz", nil)
+	assert.NoError(t, err)
+	req.Header.Set("X-Request-ID", "test-"+GenerateRandomStringSample(8))
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, req.Header.Get("X-Request-ID"), resp.Header.Get("X-Request-ID"))
+
+	resp = makeRequest(t, "GET", apiURL+"/pvz", "", nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get("X-Request-ID"))
+}